@@ -3,16 +3,16 @@
 package quicktest
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"regexp"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/kr/pretty"
 )
 
 // Checker is implemented by types used as part of Check/Assert invocations.
@@ -69,7 +69,10 @@ func (c *equalsChecker) Check(got interface{}, args []interface{}, note func(key
 	}
 
 	// Customize error message for non-nil errors.
-	if _, ok := got.(error); ok && want == nil {
+	if got, ok := got.(error); ok && want == nil {
+		if chain, ok := errorChain(got); ok {
+			note("error chain", chain)
+		}
 		return errors.New("got non-nil error")
 	}
 
@@ -83,6 +86,9 @@ func (c *equalsChecker) Check(got interface{}, args []interface{}, note func(key
 				note("want type", Unquoted(wantType.String()))
 			}
 		}
+		if chain, ok := errorChain(got); ok {
+			note("error chain", chain)
+		}
 		return errors.New("values are not equal")
 	}
 
@@ -94,8 +100,7 @@ func (c *equalsChecker) Check(got interface{}, args []interface{}, note func(key
 				return i != -1 && i < len(s)-1
 			}
 			if isMultiLine(got) || isMultiLine(want) {
-				diff := cmp.Diff(strings.SplitAfter(want, "\n"), strings.SplitAfter(got, "\n"))
-				note("line diff (-want +got)", Unquoted(diff))
+				note("line diff (-want +got)", Unquoted(lineDiff(got, want)))
 			}
 		}
 	}
@@ -103,6 +108,11 @@ func (c *equalsChecker) Check(got interface{}, args []interface{}, note func(key
 	return errors.New("values are not equal")
 }
 
+// lineDiff returns the diff between the lines of the given strings.
+func lineDiff(got, want string) string {
+	return cmp.Diff(strings.SplitAfter(want, "\n"), strings.SplitAfter(got, "\n"))
+}
+
 // CmpEquals returns a Checker checking equality of two arbitrary values
 // according to the provided compare options. See DeepEquals as an example of
 // such a checker, commonly used when no compare options are required.
@@ -158,10 +168,7 @@ var DeepEquals = CmpEquals()
 
 // ContentEquals is like DeepEquals but any slices in the compared values will
 // be sorted before being compared.
-var ContentEquals = CmpEquals(cmpopts.SortSlices(func(x, y interface{}) bool {
-	// TODO frankban: implement a proper sort function.
-	return pretty.Sprint(x) < pretty.Sprint(y)
-}))
+var ContentEquals = CmpEquals(sortSlices())
 
 // Matches is a Checker checking that the provided string or fmt.Stringer
 // matches the provided regular expression pattern.
@@ -249,15 +256,9 @@ type panicMatchesChecker struct {
 // Check implements Checker.Check by checking that got is a func() that panics
 // with a message matching args[0].
 func (c *panicMatchesChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) (err error) {
-	f := reflect.ValueOf(got)
-	if f.Kind() != reflect.Func {
-		note("got", got)
-		return BadCheckf("first argument is not a function")
-	}
-	ftype := f.Type()
-	if ftype.NumIn() != 0 {
-		note("function", got)
-		return BadCheckf("cannot use a function receiving arguments")
+	f, err := funcValue(got, note)
+	if err != nil {
+		return err
 	}
 
 	defer func() {
@@ -536,9 +537,59 @@ func (c *notChecker) Check(got interface{}, args []interface{}, note func(key st
 	if c.Checker == IsNil {
 		return errors.New("got nil value but want non-nil")
 	}
+	if c.Checker == IsZero {
+		return errors.New("got zero value but want non-zero")
+	}
 	return errors.New("unexpected success")
 }
 
+// Bind returns a Checker calling the given checker with the given arguments.
+// The resulting checker does not accept arguments, which is useful when
+// providing checkers to other checkers, like MatchesGroups.
+//
+// For instance:
+//
+//	c.Assert(answer, qt.Bind(qt.Equals, 42))
+//	c.Assert(err, qt.MatchesGroups(`user (?P<id>\d+) not found`, map[string]qt.Checker{
+//		"id": qt.Bind(qt.Equals, "42"),
+//	}))
+func Bind(checker Checker, args ...interface{}) Checker {
+	return &bindChecker{
+		checker: checker,
+		args:    args,
+	}
+}
+
+type bindChecker struct {
+	checker Checker
+	args    []interface{}
+}
+
+// Check implements Checker.Check by checking got with c.checker and c.args.
+func (c *bindChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	argNames := c.checker.ArgNames()
+	if want := len(argNames) - 1; len(c.args) != want {
+		if len(c.args) > want {
+			return BadCheckf("too many arguments bound to checker: got %d, want %d", len(c.args), want)
+		}
+		return BadCheckf("not enough arguments bound to checker: got %d, want %d", len(c.args), want)
+	}
+	err := c.checker.Check(got, c.args, note)
+	if err == nil || IsBadCheck(err) || err == ErrSilent {
+		return err
+	}
+	// Bound arguments are not included in the report, so add them as notes.
+	for i, name := range argNames[1:] {
+		note(name, c.args[i])
+	}
+	return err
+}
+
+// ArgNames implements Checker.ArgNames.
+func (c *bindChecker) ArgNames() []string {
+	return c.checker.ArgNames()[:1]
+}
+
 // Contains is a checker that checks that a map, slice, array
 // or string contains a value. It's the same as using
 // Any(Equals), except that it has a special case
@@ -696,8 +747,33 @@ func (c *allChecker) Check(got interface{}, args []interface{}, notef func(key s
 // For instance:
 //
 //	c.Assert(`{"First": 47.11}`, qt.JSONEquals, &MyStruct{First: 47.11})
+//	c.Assert(&MyStruct{First: 47.11}, qt.JSONEquals, qt.Encoded(`{"First": 47.11}`))
 var JSONEquals = CodecEquals(json.Marshal, json.Unmarshal)
 
+// UnmarshalJSONNumbers is like json.Unmarshal, but it decodes JSON numbers
+// into json.Number values rather than float64 values, so that large integers
+// are not rounded. Note that numbers are then compared by their text, so
+// that 1 and 1.0 are not considered equal. It can be used with CodecEquals,
+// for instance:
+//
+//	c.Assert(got, qt.CodecEquals(json.Marshal, qt.UnmarshalJSONNumbers), want)
+func UnmarshalJSONNumbers(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("invalid data after top-level value")
+	}
+	return nil
+}
+
+// Encoded holds data already encoded with the codec used by a CodecEquals
+// checker, like JSONEquals. It can be used to provide the wanted value as
+// encoded text rather than as a Go value.
+type Encoded string
+
 type codecEqualChecker struct {
 	argNames
 	marshal    func(interface{}) ([]byte, error)
@@ -707,12 +783,15 @@ type codecEqualChecker struct {
 
 // CodecEquals returns a checker that checks for codec value equivalence.
 //
-// It expects two arguments: a byte slice or a string containing some
-// codec-marshaled data, and a Go value.
+// It expects two arguments: the obtained value and the wanted value. A byte
+// slice, a string or an Encoded value is taken to contain some
+// codec-marshaled data. Any other value is a Go value, which is first
+// marshaled using marshal.
 //
 // It uses unmarshal to unmarshal the data into an interface{} value.
-// It marshals the Go value using marshal, then unmarshals the result into
-// an interface{} value.
+// Go values are marshaled using marshal, then the result is unmarshaled into
+// an interface{} value. The wanted value is always marshaled, unless it is of
+// type Encoded.
 //
 // It then checks that the two interface{} values are deep-equal to one
 // another, using CmpEquals(opts) to perform the check.
@@ -736,15 +815,24 @@ func (c *codecEqualChecker) Check(got interface{}, args []interface{}, note func
 	switch got := got.(type) {
 	case string:
 		gotContent = []byte(got)
+	case Encoded:
+		gotContent = []byte(got)
 	case []byte:
 		gotContent = got
 	default:
-		return BadCheckf("expected string or byte, got %T", got)
+		var err error
+		if gotContent, err = c.marshal(got); err != nil {
+			return BadCheckf("cannot marshal obtained contents: %v", err)
+		}
 	}
-	wantContent := args[0]
-	wantContentBytes, err := c.marshal(wantContent)
-	if err != nil {
-		return BadCheckf("cannot marshal expected contents: %v", err)
+	var wantContentBytes []byte
+	if wantContent, ok := args[0].(Encoded); ok {
+		wantContentBytes = []byte(wantContent)
+	} else {
+		var err error
+		if wantContentBytes, err = c.marshal(args[0]); err != nil {
+			return BadCheckf("cannot marshal expected contents: %v", err)
+		}
 	}
 	var wantContentVal interface{}
 	if err := c.unmarshal(wantContentBytes, &wantContentVal); err != nil {
@@ -765,6 +853,48 @@ func (a argNames) ArgNames() []string {
 	return a
 }
 
+// checkNested runs the given checker, used as part of another checker, on got
+// and args. On failure, the notes added by the checker are passed to notef. If
+// the checker error is not silent, the notes are preceded by the error, by the
+// given context notes and by got, described by gotName unless it is empty, and
+// they are followed by the checker arguments, so that the report is complete
+// even if the outer checker returns ErrSilent. Otherwise, the context notes are
+// added last.
+//
+// It returns nil on success, the BadCheck error returned by the checker, or
+// ErrSilent.
+func checkNested(checker Checker, got interface{}, args []interface{}, gotName string, notef func(key string, value interface{}), context ...note) error {
+	var notes []note
+	err := checker.Check(got, args, func(key string, value interface{}) {
+		notes = append(notes, note{key, value})
+	})
+	if err == nil {
+		return nil
+	}
+	if IsBadCheck(err) || err == ErrSilent {
+		for _, n := range append(notes, context...) {
+			notef(n.key, n.value)
+		}
+		return err
+	}
+	notef("error", Unquoted(err.Error()))
+	for _, n := range context {
+		notef(n.key, n.value)
+	}
+	if gotName != "" {
+		notef(gotName, got)
+	}
+	for _, n := range notes {
+		notef(n.key, n.value)
+	}
+	for i, name := range checker.ArgNames()[1:] {
+		if i < len(args) {
+			notef(name, args[i])
+		}
+	}
+	return ErrSilent
+}
+
 // match checks that the given error message matches the given pattern.
 func match(got string, pattern interface{}, msg string, note func(key string, value interface{})) error {
 	if actualRegex, ok := pattern.(*regexp.Regexp); ok {
@@ -0,0 +1,303 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+)
+
+// ApproxOption configures how approximate equality checkers handle NaN and
+// infinite values. Options can be combined with the | operator.
+type ApproxOption int
+
+const (
+	// EquateNaNs makes NaN values equal to each other. By default, as with the
+	// == operator, NaN values are never equal.
+	EquateNaNs ApproxOption = 1 << iota
+
+	// RejectInfs makes any comparison involving infinite values fail. By
+	// default, infinite values are equal when they have the same sign.
+	RejectInfs
+)
+
+// ApproxEquals returns a Checker checking that two numbers are equal within
+// the given absolute tolerance, which means that |got-want| <= tolerance.
+// Floating point, complex and integer values are supported, as well as slices,
+// arrays and maps of them, in which case the elements are compared pairwise.
+//
+// For instance:
+//
+//	c.Assert(math.Pi, qt.ApproxEquals(0.01), 3.14)
+//	c.Assert(results, qt.ApproxEquals(1e-9), []float64{0.3, 1})
+//	c.Assert(math.NaN(), qt.ApproxEquals(0, qt.EquateNaNs), math.NaN())
+func ApproxEquals(tolerance float64, opts ...ApproxOption) Checker {
+	return &approxChecker{
+		argNames:     []string{"got", "want"},
+		distanceKey:  "delta",
+		toleranceKey: "tolerance",
+		tolerance:    tolerance,
+		opts:         combineApproxOptions(opts),
+		within: func(got, want number) (interface{}, bool, error) {
+			if tolerance < 0 || math.IsNaN(tolerance) {
+				return nil, false, BadCheckf("invalid tolerance %v", tolerance)
+			}
+			delta := cmplx.Abs(got.c - want.c)
+			return delta, delta <= tolerance, nil
+		},
+	}
+}
+
+// RelEquals returns a Checker checking that two numbers are equal within the
+// given relative tolerance, which means that
+// |got-want| <= tolerance*max(|got|, |want|). The same values as for
+// ApproxEquals are supported.
+//
+// For instance:
+//
+//	c.Assert(1.001e9, qt.RelEquals(0.01), 1e9)
+func RelEquals(tolerance float64, opts ...ApproxOption) Checker {
+	return &approxChecker{
+		argNames:     []string{"got", "want"},
+		distanceKey:  "relative delta",
+		toleranceKey: "relative tolerance",
+		tolerance:    tolerance,
+		opts:         combineApproxOptions(opts),
+		within: func(got, want number) (interface{}, bool, error) {
+			if tolerance < 0 || math.IsNaN(tolerance) {
+				return nil, false, BadCheckf("invalid relative tolerance %v", tolerance)
+			}
+			delta := cmplx.Abs(got.c - want.c)
+			if delta == 0 {
+				return 0.0, true, nil
+			}
+			delta /= math.Max(cmplx.Abs(got.c), cmplx.Abs(want.c))
+			return delta, delta <= tolerance, nil
+		},
+	}
+}
+
+// ULPEquals returns a Checker checking that two floating point numbers are at
+// most the given number of units in the last place (ULPs) apart, which means
+// that at most ulps representable values lie between them. Values are
+// compared with float32 precision when neither of them is a float64 or
+// a complex128. For complex numbers, the real and imaginary parts are compared
+// separately. The same values as for ApproxEquals are supported.
+//
+// For instance:
+//
+//	c.Assert(math.Sqrt(2)*math.Sqrt(2), qt.ULPEquals(1), 2.0)
+func ULPEquals(ulps uint64, opts ...ApproxOption) Checker {
+	return &approxChecker{
+		argNames:     []string{"got", "want"},
+		distanceKey:  "ULP distance",
+		toleranceKey: "max ULPs",
+		tolerance:    ulps,
+		opts:         combineApproxOptions(opts),
+		within: func(got, want number) (interface{}, bool, error) {
+			is32 := got.bits != 64 && want.bits != 64 && got.bits+want.bits != 0
+			distance := ulpDistance(real(got.c), real(want.c), is32)
+			if d := ulpDistance(imag(got.c), imag(want.c), is32); d > distance {
+				distance = d
+			}
+			return distance, distance <= ulps, nil
+		},
+	}
+}
+
+type approxChecker struct {
+	argNames
+	// within reports whether the given numbers are close enough, also
+	// returning their distance.
+	within func(got, want number) (distance interface{}, ok bool, err error)
+	// distanceKey and toleranceKey hold the names of the notes used to
+	// describe the distance and the tolerance.
+	distanceKey  string
+	toleranceKey string
+	tolerance    interface{}
+	opts         ApproxOption
+}
+
+// Check implements Checker.Check by checking that got and args[0] are
+// approximately equal, or that their elements are if they are containers.
+func (c *approxChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	want := args[0]
+	gotv, wantv := reflect.ValueOf(got), reflect.ValueOf(want)
+	if !isContainer(gotv) && !isContainer(wantv) {
+		return c.checkNumbers(gotv, wantv, "", note)
+	}
+	if !isContainer(gotv) || !isContainer(wantv) || (gotv.Kind() == reflect.Map) != (wantv.Kind() == reflect.Map) {
+		return BadCheckf("cannot compare %T with %T", got, want)
+	}
+	if gotv.Len() != wantv.Len() {
+		note("len(got)", gotv.Len())
+		note("len(want)", wantv.Len())
+		return errors.New("containers have different lengths")
+	}
+	iter, err := newIter(got)
+	if err != nil {
+		return BadCheckf("%v", err)
+	}
+	for iter.next() {
+		var wantElem reflect.Value
+		if wantv.Kind() == reflect.Map {
+			key := iter.keyValue()
+			if !key.Type().AssignableTo(wantv.Type().Key()) {
+				return BadCheckf("cannot compare %T with %T", got, want)
+			}
+			wantElem = wantv.MapIndex(key)
+			if !wantElem.IsValid() {
+				return fmt.Errorf("%s not found in want", iter.key())
+			}
+		} else {
+			wantElem = wantv.Index(int(iter.keyValue().Int()))
+		}
+		if err := c.checkNumbers(iter.value(), wantElem, iter.key(), note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNumbers checks that the given numbers are approximately equal. If the
+// numbers are container elements, at holds their position.
+func (c *approxChecker) checkNumbers(gotv, wantv reflect.Value, at string, note func(key string, value interface{})) error {
+	badCheckf := func(format string, a ...interface{}) error {
+		if at != "" {
+			return BadCheckf("at %s: %s", at, fmt.Sprintf(format, a...))
+		}
+		return BadCheckf(format, a...)
+	}
+	g, ok := toNumber(gotv)
+	if !ok {
+		return badCheckf("got value is not a number")
+	}
+	w, ok := toNumber(wantv)
+	if !ok {
+		return badCheckf("want value is not a number")
+	}
+
+	var distance interface{}
+	var err error
+	switch {
+	case isNaN(g.c) || isNaN(w.c):
+		distance = math.NaN()
+		ok = isNaN(g.c) && isNaN(w.c) && c.opts&EquateNaNs != 0
+	case cmplx.IsInf(g.c) || cmplx.IsInf(w.c):
+		distance = math.Inf(1)
+		ok = g.c == w.c && c.opts&RejectInfs == 0
+		if c.opts&RejectInfs != 0 {
+			err = errors.New("infinite values are not allowed")
+		}
+	default:
+		distance, ok, err = c.within(g, w)
+		if IsBadCheck(err) {
+			return err
+		}
+	}
+	if ok {
+		return nil
+	}
+	if err == nil {
+		err = errors.New("values are not approximately equal")
+	}
+	if at != "" {
+		note("mismatch at", Unquoted(at))
+		note("got element", gotv.Interface())
+		note("want element", wantv.Interface())
+	}
+	note(c.distanceKey, distance)
+	note(c.toleranceKey, c.tolerance)
+	return err
+}
+
+// number holds a numeric value converted for approximate comparisons.
+type number struct {
+	c complex128
+	// bits holds the precision of the value, which is 32 or 64 for floating
+	// point and complex numbers, and 0 for integers.
+	bits int
+}
+
+// toNumber converts the given value to a number, reporting whether the
+// conversion was possible.
+func toNumber(v reflect.Value) (number, bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Float32:
+		return number{c: complex(v.Float(), 0), bits: 32}, true
+	case reflect.Float64:
+		return number{c: complex(v.Float(), 0), bits: 64}, true
+	case reflect.Complex64:
+		return number{c: v.Complex(), bits: 32}, true
+	case reflect.Complex128:
+		return number{c: v.Complex(), bits: 64}, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{c: complex(float64(v.Int()), 0)}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{c: complex(float64(v.Uint()), 0)}, true
+	}
+	return number{}, false
+}
+
+// isContainer reports whether the given value is a map, slice or array.
+func isContainer(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+func isNaN(c complex128) bool {
+	return math.IsNaN(real(c)) || math.IsNaN(imag(c))
+}
+
+// ulpDistance returns the number of units in the last place between the
+// given finite floating point numbers. If is32 is true, the numbers are
+// converted to float32 first.
+func ulpDistance(x, y float64, is32 bool) uint64 {
+	var a, b int64
+	if is32 {
+		a, b = orderedBits32(float32(x)), orderedBits32(float32(y))
+	} else {
+		a, b = orderedBits64(x), orderedBits64(y)
+	}
+	if a < b {
+		a, b = b, a
+	}
+	return uint64(a) - uint64(b)
+}
+
+// orderedBits64 returns the bits of the given number, interpreted as a signed
+// integer and mapped so that the ordering of the result matches the ordering
+// of the numbers. Both positive and negative zero are mapped to 0.
+func orderedBits64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+	return bits
+}
+
+// orderedBits32 is like orderedBits64 for float32 values.
+func orderedBits32(f float32) int64 {
+	bits := int64(int32(math.Float32bits(f)))
+	if bits < 0 {
+		return math.MinInt32 - bits
+	}
+	return bits
+}
+
+func combineApproxOptions(opts []ApproxOption) ApproxOption {
+	var o ApproxOption
+	for _, opt := range opts {
+		o |= opt
+	}
+	return o
+}
@@ -0,0 +1,416 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"math"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, approxCheckerTests...)
+}
+
+var approxCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "ApproxEquals: within tolerance",
+	checker: qt.ApproxEquals(0.01),
+	got:     math.Pi,
+	args:    []interface{}{3.14},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(3.141592653589793)
+want:
+  float64(3.14)
+`,
+}, {
+	about:   "ApproxEquals: outside tolerance",
+	checker: qt.ApproxEquals(0.001),
+	got:     math.Pi,
+	args:    []interface{}{3.14},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+delta:
+  float64(0.0015926535897929917)
+tolerance:
+  float64(0.001)
+got:
+  float64(3.141592653589793)
+want:
+  float64(3.14)
+`,
+}, {
+	about:   "ApproxEquals: integer want",
+	checker: qt.ApproxEquals(0.5),
+	got:     float32(1.25),
+	args:    []interface{}{1},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float32(1.25)
+want:
+  int(1)
+`,
+}, {
+	about:   "ApproxEquals: complex numbers",
+	checker: qt.ApproxEquals(0.5),
+	got:     complex(1, 1),
+	args:    []interface{}{complex(1, 2)},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+delta:
+  float64(1)
+tolerance:
+  float64(0.5)
+got:
+  (1+1i)
+want:
+  (1+2i)
+`,
+}, {
+	about:   "ApproxEquals: slices",
+	checker: qt.ApproxEquals(0.1),
+	got:     []float64{1, 2, 3},
+	args:    []interface{}{[]float64{1.05, 2.5, 3}},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+mismatch at:
+  index 1
+got element:
+  float64(2)
+want element:
+  float64(2.5)
+delta:
+  float64(0.5)
+tolerance:
+  float64(0.1)
+got:
+  []float64{1, 2, 3}
+want:
+  []float64{1.05, 2.5, 3}
+`,
+}, {
+	about:   "ApproxEquals: slice and array",
+	checker: qt.ApproxEquals(0.1),
+	got:     []float64{1, 2},
+	args:    []interface{}{[2]float32{1.05, 2}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []float64{1, 2}
+want:
+  [2]float32{1.0499999523162842, 2}
+`,
+}, {
+	about:   "ApproxEquals: different lengths",
+	checker: qt.ApproxEquals(0.1),
+	got:     []float64{1, 2},
+	args:    []interface{}{[]float64{1}},
+	expectedCheckFailure: `
+error:
+  containers have different lengths
+len(got):
+  int(2)
+len(want):
+  int(1)
+got:
+  []float64{1, 2}
+want:
+  []float64{1}
+`,
+}, {
+	about:   "ApproxEquals: maps",
+	checker: qt.ApproxEquals(0.1),
+	got:     map[string]float64{"a": 1, "b": 2},
+	args:    []interface{}{map[string]float64{"a": 1, "b": 2.2}},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+mismatch at:
+  key "b"
+got element:
+  float64(2)
+want element:
+  float64(2.2)
+delta:
+  float64(0.20000000000000018)
+tolerance:
+  float64(0.1)
+got:
+  map[string]float64{"a":1, "b":2}
+want:
+  map[string]float64{"a":1, "b":2.2}
+`,
+}, {
+	about:   "ApproxEquals: missing map key",
+	checker: qt.ApproxEquals(0.1),
+	got:     map[string]float64{"a": 1},
+	args:    []interface{}{map[string]float64{"b": 1}},
+	expectedCheckFailure: `
+error:
+  key "a" not found in want
+got:
+  map[string]float64{"a":1}
+want:
+  map[string]float64{"b":1}
+`,
+}, {
+	about:   "ApproxEquals: NaN values",
+	checker: qt.ApproxEquals(0.1),
+	got:     math.NaN(),
+	args:    []interface{}{math.NaN()},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+delta:
+  float64(NaN)
+tolerance:
+  float64(0.1)
+got:
+  <same as "delta">
+want:
+  <same as "delta">
+`,
+}, {
+	about:   "ApproxEquals: equate NaN values",
+	checker: qt.ApproxEquals(0.1, qt.EquateNaNs),
+	got:     []float64{math.NaN()},
+	args:    []interface{}{[]float64{math.NaN()}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []float64{NaN}
+want:
+  <same as "got">
+`,
+}, {
+	about:   "ApproxEquals: infinite values",
+	checker: qt.ApproxEquals(0.1),
+	got:     math.Inf(1),
+	args:    []interface{}{math.Inf(1)},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(+Inf)
+want:
+  <same as "got">
+`,
+}, {
+	about:   "ApproxEquals: different infinite values",
+	checker: qt.ApproxEquals(0.1),
+	got:     math.Inf(1),
+	args:    []interface{}{math.Inf(-1)},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+delta:
+  float64(+Inf)
+tolerance:
+  float64(0.1)
+got:
+  <same as "delta">
+want:
+  float64(-Inf)
+`,
+}, {
+	about:   "ApproxEquals: reject infinite values",
+	checker: qt.ApproxEquals(0.1, qt.EquateNaNs|qt.RejectInfs),
+	got:     math.Inf(1),
+	args:    []interface{}{math.Inf(1)},
+	expectedCheckFailure: `
+error:
+  infinite values are not allowed
+delta:
+  float64(+Inf)
+tolerance:
+  float64(0.1)
+got:
+  <same as "delta">
+want:
+  <same as "delta">
+`,
+}, {
+	about:   "ApproxEquals: invalid tolerance",
+	checker: qt.ApproxEquals(-1),
+	got:     1.0,
+	args:    []interface{}{1.0},
+	expectedCheckFailure: `
+error:
+  bad check: invalid tolerance -1
+`,
+	expectedNegateFailure: `
+error:
+  bad check: invalid tolerance -1
+`,
+}, {
+	about:   "ApproxEquals: not a number",
+	checker: qt.ApproxEquals(0.1),
+	got:     []interface{}{1.0, "1"},
+	args:    []interface{}{[]float64{1, 1}},
+	expectedCheckFailure: `
+error:
+  bad check: at index 1: got value is not a number
+`,
+	expectedNegateFailure: `
+error:
+  bad check: at index 1: got value is not a number
+`,
+}, {
+	about:   "ApproxEquals: container and number",
+	checker: qt.ApproxEquals(0.1),
+	got:     []float64{1},
+	args:    []interface{}{1.0},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare []float64 with float64
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare []float64 with float64
+`,
+}, {
+	about:   "RelEquals: within tolerance",
+	checker: qt.RelEquals(0.01),
+	got:     1.001e9,
+	args:    []interface{}{1e9},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(1.001e+09)
+want:
+  float64(1e+09)
+`,
+}, {
+	about:   "RelEquals: outside tolerance",
+	checker: qt.RelEquals(0.01),
+	got:     []float64{1, 100},
+	args:    []interface{}{[]float64{1, 110}},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+mismatch at:
+  index 1
+got element:
+  float64(100)
+want element:
+  float64(110)
+relative delta:
+  float64(0.09090909090909091)
+relative tolerance:
+  float64(0.01)
+got:
+  []float64{1, 100}
+want:
+  []float64{1, 110}
+`,
+}, {
+	about:   "RelEquals: zero values",
+	checker: qt.RelEquals(0),
+	got:     0.0,
+	args:    []interface{}{0},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(0)
+want:
+  int(0)
+`,
+}, {
+	about:   "ULPEquals: within distance",
+	checker: qt.ULPEquals(1),
+	got:     math.Sqrt(2) * math.Sqrt(2),
+	args:    []interface{}{2.0},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(2.0000000000000004)
+want:
+  float64(2)
+`,
+}, {
+	about:   "ULPEquals: outside distance",
+	checker: qt.ULPEquals(1),
+	got:     math.Nextafter(math.Nextafter(1, 2), 2),
+	args:    []interface{}{1.0},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+ULP distance:
+  uint64(2)
+max ULPs:
+  uint64(1)
+got:
+  float64(1.0000000000000004)
+want:
+  float64(1)
+`,
+}, {
+	about:   "ULPEquals: across zero",
+	checker: qt.ULPEquals(1),
+	got:     math.Copysign(0, -1),
+	args:    []interface{}{math.SmallestNonzeroFloat64},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float64(-0)
+want:
+  float64(5e-324)
+`,
+}, {
+	about:   "ULPEquals: float32 precision",
+	checker: qt.ULPEquals(0),
+	got:     float32(0.1),
+	args:    []interface{}{float32(0.1)},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  float32(0.10000000149011612)
+want:
+  <same as "got">
+`,
+}, {
+	about:   "ULPEquals: float32 distance",
+	checker: qt.ULPEquals(1),
+	got:     []float32{1, 2},
+	args:    []interface{}{[]float32{1, math.Nextafter32(math.Nextafter32(2, 3), 3)}},
+	expectedCheckFailure: `
+error:
+  values are not approximately equal
+mismatch at:
+  index 1
+got element:
+  float32(2)
+want element:
+  float32(2.000000476837158)
+ULP distance:
+  uint64(2)
+max ULPs:
+  uint64(1)
+got:
+  []float32{1, 2}
+want:
+  []float32{1, 2.000000476837158}
+`,
+}}
@@ -0,0 +1,126 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Eventually returns a Checker that repeatedly calls the provided function,
+// which must be of type func() T, and checks the returned value using the
+// given checker, until the check succeeds. The check fails if it does not
+// succeed within the given timeout. The given interval is waited between
+// attempts.
+//
+// The checker arguments, if any, must be provided after the function.
+// On failure, the number of attempts, the elapsed time and the value and notes
+// from the last attempt are reported.
+//
+// For instance:
+//
+//	c.Assert(func() int { return counter.Load() }, qt.Eventually(qt.Equals, time.Second, 10*time.Millisecond), 42)
+//	c.Assert(srv.Ready, qt.Eventually(qt.IsTrue, 5*time.Second, 100*time.Millisecond))
+func Eventually(checker Checker, timeout, interval time.Duration) Checker {
+	return &pollChecker{
+		argNames:   append([]string{"function"}, checker.ArgNames()[1:]...),
+		checker:    checker,
+		duration:   timeout,
+		interval:   interval,
+		eventually: true,
+	}
+}
+
+// Consistently returns a Checker that repeatedly calls the provided function,
+// which must be of type func() T, and checks the returned value using the
+// given checker, for the given duration. The check fails as soon as one of the
+// attempts fails. The given interval is waited between attempts.
+//
+// The checker arguments, if any, must be provided after the function.
+// On failure, the number of attempts, the elapsed time and the value and notes
+// from the failed attempt are reported.
+//
+// For instance:
+//
+//	c.Assert(func() int { return len(queue) }, qt.Consistently(qt.Equals, time.Second, 10*time.Millisecond), 0)
+func Consistently(checker Checker, duration, interval time.Duration) Checker {
+	return &pollChecker{
+		argNames: append([]string{"function"}, checker.ArgNames()[1:]...),
+		checker:  checker,
+		duration: duration,
+		interval: interval,
+	}
+}
+
+type pollChecker struct {
+	argNames
+	checker  Checker
+	duration time.Duration
+	interval time.Duration
+	// eventually holds whether the check must succeed at least once rather
+	// than on every attempt.
+	eventually bool
+}
+
+// Check implements Checker.Check by repeatedly checking the result of calling
+// got with c.checker.
+func (c *pollChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	f := reflect.ValueOf(got)
+	if f.Kind() != reflect.Func {
+		notef("got", got)
+		return BadCheckf("first argument is not a function")
+	}
+	if ftype := f.Type(); ftype.NumIn() != 0 || ftype.NumOut() != 1 {
+		notef("function", got)
+		return BadCheckf("function must not receive arguments and must return a single value")
+	}
+
+	start := time.Now()
+	for attempts := 1; ; attempts++ {
+		value := f.Call(nil)[0].Interface()
+		var notes []note
+		err := c.checker.Check(value, args, func(key string, val interface{}) {
+			notes = append(notes, note{key, val})
+		})
+		if IsBadCheck(err) {
+			for _, n := range notes {
+				notef(n.key, n.value)
+			}
+			return err
+		}
+		elapsed := time.Since(start)
+		switch {
+		case err == nil && (c.eventually || elapsed >= c.duration):
+			// The check succeeded either once or for the whole duration.
+			return nil
+		case err == nil || c.eventually && elapsed < c.duration:
+			c.wait(elapsed)
+			continue
+		}
+
+		notef("attempts", attempts)
+		notef("elapsed", Unquoted(elapsed.String()))
+		if err != ErrSilent {
+			notef("last error", Unquoted(err.Error()))
+		}
+		notef("last value", value)
+		for _, n := range notes {
+			notef(n.key, n.value)
+		}
+		if c.eventually {
+			return fmt.Errorf("check did not succeed within %v", c.duration)
+		}
+		return fmt.Errorf("check did not succeed for %v", c.duration)
+	}
+}
+
+// wait waits for the next attempt, given the time elapsed since the first
+// attempt. The last attempt is performed when c.duration expires.
+func (c *pollChecker) wait(elapsed time.Duration) {
+	d := c.interval
+	if remaining := c.duration - elapsed; remaining < d {
+		d = remaining
+	}
+	time.Sleep(d)
+}
@@ -0,0 +1,236 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, asyncCheckerTests...)
+}
+
+var asyncCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "Eventually: success at first attempt",
+	checker: qt.Eventually(qt.Equals, time.Second, time.Millisecond),
+	got:     func() int { return 42 },
+	args:    []interface{}{42},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() int {...}
+want:
+  int(42)
+`,
+}, {
+	about:   "Eventually: not a function",
+	checker: qt.Eventually(qt.Equals, time.Second, time.Millisecond),
+	got:     42,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  int(42)
+`,
+}, {
+	about:   "Eventually: function with arguments",
+	checker: qt.Eventually(qt.IsTrue, time.Second, time.Millisecond),
+	got:     func(bool) bool { return true },
+	expectedCheckFailure: `
+error:
+  bad check: function must not receive arguments and must return a single value
+function:
+  func(bool) bool {...}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: function must not receive arguments and must return a single value
+function:
+  func(bool) bool {...}
+`,
+}, {
+	about:   "Eventually: bad check from checker",
+	checker: qt.Eventually(qt.HasLen, time.Second, time.Millisecond),
+	got:     func() int { return 42 },
+	args:    []interface{}{1},
+	expectedCheckFailure: `
+error:
+  bad check: first argument has no length
+got:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument has no length
+got:
+  int(42)
+`,
+}, {
+	about:   "Consistently: not a function",
+	checker: qt.Consistently(qt.IsNil, time.Second, time.Millisecond),
+	got:     "bad wolf",
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  "bad wolf"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  "bad wolf"
+`,
+}, {
+	about:   "Consistently: function returning multiple values",
+	checker: qt.Consistently(qt.IsNil, time.Second, time.Millisecond),
+	got:     func() (int, error) { return 0, nil },
+	expectedCheckFailure: `
+error:
+  bad check: function must not receive arguments and must return a single value
+function:
+  func() (int, error) {...}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: function must not receive arguments and must return a single value
+function:
+  func() (int, error) {...}
+`,
+}}
+
+func TestEventuallySuccess(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	var n int
+	f := func() int {
+		n++
+		return n
+	}
+	ok := c.Check(f, qt.Eventually(qt.Equals, 10*time.Second, time.Millisecond), 3)
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+	qt.Assert(t, n, qt.Equals, 3)
+}
+
+func TestEventuallyFailure(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	start := time.Now()
+	ok := c.Check(func() string { return "bad wolf" }, qt.Eventually(qt.Equals, 50*time.Millisecond, 10*time.Millisecond), "good wolf")
+	assertBool(t, ok, false)
+	qt.Assert(t, time.Since(start), qt.AtLeast, 50*time.Millisecond)
+	qt.Assert(t, tt.errorString(), qt.Matches, `
+error:
+  check did not succeed within 50ms
+attempts:
+  int\(\d+\)
+elapsed:
+  \d+(\.\d+)?ms
+last error:
+  values are not equal
+last value:
+  "bad wolf"
+function:
+  func\(\) string \{...\}
+want:
+  "good wolf"
+stack:
+(.|\n)*`)
+}
+
+func TestEventuallyFailureNotes(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ok := c.Check(func() []int { return []int{42} }, qt.Eventually(qt.DeepEquals, 0, 0), []int{47})
+	assertBool(t, ok, false)
+	qt.Assert(t, tt.errorString(), qt.Matches, `
+error:
+  check did not succeed within 0s
+attempts:
+  int\(1\)
+elapsed:
+  .*
+last value:
+  \[\]int\{42\}
+error:
+  values are not deep equal
+diff \(-want \+got\):
+(.|\n)*
+got:
+  \[\]int\{42\}
+want:
+  \[\]int\{47\}
+function:
+  func\(\) \[\]int \{...\}
+want:
+  <same as "want">
+stack:
+(.|\n)*`)
+}
+
+func TestConsistentlySuccess(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	var n int
+	f := func() bool {
+		n++
+		return true
+	}
+	start := time.Now()
+	ok := c.Check(f, qt.Consistently(qt.IsTrue, 50*time.Millisecond, 10*time.Millisecond))
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+	qt.Assert(t, time.Since(start), qt.AtLeast, 50*time.Millisecond)
+	qt.Assert(t, n, qt.Between, 2, 7)
+}
+
+func TestConsistentlyFailure(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	var n int
+	f := func() int {
+		n++
+		return n * 10
+	}
+	ok := c.Check(f, qt.Consistently(qt.LessThan, 10*time.Second, time.Millisecond), 30)
+	assertBool(t, ok, false)
+	qt.Assert(t, tt.errorString(), qt.Matches, `
+error:
+  check did not succeed for 10s
+attempts:
+  int\(3\)
+elapsed:
+  .*
+last error:
+  value is not less than bound
+last value:
+  int\(30\)
+got - bound:
+  int\(0\)
+function:
+  func\(\) int \{...\}
+bound:
+  <same as "last value">
+stack:
+(.|\n)*`)
+}
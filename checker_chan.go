@@ -0,0 +1,183 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Receives returns a Checker receiving a value from the provided channel and
+// checking it using the given checker. The checker arguments, if any, must be
+// provided after the channel, followed by the time.Duration to wait for a
+// value before failing.
+//
+// For instance:
+//
+//	c.Assert(ch, qt.Receives(qt.Equals), 42, time.Second)
+//	c.Assert(events, qt.Receives(qt.DeepEquals), Event{Name: "start"}, 100*time.Millisecond)
+//
+// See also ReceivesWithin.
+func Receives(checker Checker) Checker {
+	argNames := append([]string{"channel"}, checker.ArgNames()[1:]...)
+	return &receivesChecker{
+		argNames:   append(argNames, "timeout"),
+		checker:    checker,
+		timeoutArg: true,
+	}
+}
+
+// ReceivesWithin is like Receives, except that the time to wait for a value
+// is provided when creating the checker, and therefore the channel must only
+// be followed by the checker arguments.
+//
+// For instance:
+//
+//	c.Assert(ch, qt.ReceivesWithin(qt.Equals, time.Second), 42)
+//	c.Assert(done, qt.ReceivesWithin(qt.IsTrue, time.Second))
+func ReceivesWithin(checker Checker, timeout time.Duration) Checker {
+	return &receivesChecker{
+		argNames: append([]string{"channel"}, checker.ArgNames()[1:]...),
+		checker:  checker,
+		timeout:  timeout,
+	}
+}
+
+type receivesChecker struct {
+	argNames
+	checker Checker
+	timeout time.Duration
+	// timeoutArg holds whether the timeout is provided as the last argument.
+	timeoutArg bool
+}
+
+// Check implements Checker.Check by receiving a value from got and checking
+// it with c.checker.
+func (c *receivesChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	timeout := c.timeout
+	if c.timeoutArg {
+		var ok bool
+		timeout, ok = args[len(args)-1].(time.Duration)
+		if !ok {
+			note("timeout", args[len(args)-1])
+			return BadCheckf("timeout is not a time.Duration")
+		}
+		args = args[:len(args)-1]
+	}
+	v, err := recvChannel(got, note)
+	if err != nil {
+		return err
+	}
+	// Values already in the channel are received even with a zero timeout, as
+	// reflect.Select chooses randomly among the ready cases.
+	value, ok := v.TryRecv()
+	if !value.IsValid() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		var chosen int
+		chosen, value, ok = reflect.Select([]reflect.SelectCase{{
+			Dir:  reflect.SelectRecv,
+			Chan: v,
+		}, {
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(timer.C),
+		}})
+		if chosen == 1 {
+			// A value may have arrived while the timer fired.
+			if value, ok = v.TryRecv(); !value.IsValid() {
+				return fmt.Errorf("no value received within %v", timeout)
+			}
+		}
+	}
+	if !ok {
+		return errors.New("channel closed before receiving a value")
+	}
+	return checkNested(c.checker, value.Interface(), args, "received value", note)
+}
+
+// IsClosed is a Checker checking that the provided channel is closed, which
+// means that a receive operation would not block and would not return a value.
+// Values buffered in the channel are reported without being received. Note
+// that, for unbuffered channels, a value sent by a blocked sender is received,
+// and therefore consumed, when the channel is not closed.
+//
+// For instance:
+//
+//	c.Assert(done, qt.IsClosed)
+var IsClosed Checker = &chanStateChecker{
+	wantClosed: true,
+}
+
+// IsChanEmpty is a Checker checking that the provided channel has no value
+// ready to be received. A closed channel is empty. Values buffered in the
+// channel are reported without being received. Note that, for unbuffered
+// channels, a value sent by a blocked sender is received, and therefore
+// consumed, when the channel is not empty.
+//
+// For instance:
+//
+//	c.Assert(errs, qt.IsChanEmpty)
+var IsChanEmpty Checker = &chanStateChecker{}
+
+type chanStateChecker struct {
+	// wantClosed holds whether the channel must also be closed.
+	wantClosed bool
+}
+
+// Check implements Checker.Check by checking that got has no values ready to
+// be received and that, if c.wantClosed is true, it is closed.
+func (c *chanStateChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	v, err := recvChannel(got, note)
+	if err != nil {
+		return err
+	}
+	if n := v.Len(); n > 0 {
+		note("buffered values", n)
+		return c.failure()
+	}
+	if v.Cap() > 0 && !c.wantClosed {
+		return nil
+	}
+	// Receiving is the only way to tell whether the channel is closed, or
+	// whether a sender is blocked on an unbuffered channel.
+	value, ok := v.TryRecv()
+	if ok {
+		note("received value", value.Interface())
+		return c.failure()
+	}
+	if c.wantClosed && !value.IsValid() {
+		// The receive operation would block.
+		return errors.New("channel is not closed")
+	}
+	return nil
+}
+
+// failure returns the error reported when a value is ready to be received.
+func (c *chanStateChecker) failure() error {
+	if c.wantClosed {
+		return errors.New("channel is not closed")
+	}
+	return errors.New("channel is not empty")
+}
+
+// ArgNames implements Checker.ArgNames.
+func (c *chanStateChecker) ArgNames() []string {
+	return []string{"channel"}
+}
+
+// recvChannel returns the reflect value for the given channel, or a BadCheck
+// error if it is not a channel that can be received from.
+func recvChannel(got interface{}, note func(key string, value interface{})) (reflect.Value, error) {
+	v := reflect.ValueOf(got)
+	if v.Kind() != reflect.Chan {
+		note("got", got)
+		return reflect.Value{}, BadCheckf("first argument is not a channel")
+	}
+	if v.Type().ChanDir()&reflect.RecvDir == 0 {
+		note("channel", got)
+		return reflect.Value{}, BadCheckf("cannot receive from send-only channel")
+	}
+	return v, nil
+}
@@ -0,0 +1,365 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, chanCheckerTests...)
+}
+
+var (
+	closedChan   = newClosedChan()
+	emptyChan    = make(chan int)
+	sendOnlyChan = (chan<- int)(make(chan int))
+)
+
+func newClosedChan() chan int {
+	ch := make(chan int)
+	close(ch)
+	return ch
+}
+
+var chanCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "Receives: not a channel",
+	checker: qt.Receives(qt.Equals),
+	got:     42,
+	args:    []interface{}{42, time.Second},
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a channel
+got:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a channel
+got:
+  int(42)
+`,
+}, {
+	about:   "Receives: send-only channel",
+	checker: qt.Receives(qt.Equals),
+	got:     sendOnlyChan,
+	args:    []interface{}{42, time.Second},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  bad check: cannot receive from send-only channel
+channel:
+  (chan<- int)(%v)
+`, sendOnlyChan),
+	expectedNegateFailure: fmt.Sprintf(`
+error:
+  bad check: cannot receive from send-only channel
+channel:
+  (chan<- int)(%v)
+`, sendOnlyChan),
+}, {
+	about:   "Receives: invalid timeout",
+	checker: qt.Receives(qt.Equals),
+	got:     emptyChan,
+	args:    []interface{}{42, 1000},
+	expectedCheckFailure: `
+error:
+  bad check: timeout is not a time.Duration
+timeout:
+  int(1000)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: timeout is not a time.Duration
+timeout:
+  int(1000)
+`,
+}, {
+	about:   "Receives: not enough arguments",
+	checker: qt.Receives(qt.Equals),
+	got:     emptyChan,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 1, want 2
+got args:
+  []interface {}{
+      int(42),
+  }
+want args:
+  want, timeout
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 1, want 2
+got args:
+  []interface {}{
+      int(42),
+  }
+want args:
+  want, timeout
+`,
+}, {
+	about:   "Receives: closed channel",
+	checker: qt.Receives(qt.Equals),
+	got:     closedChan,
+	args:    []interface{}{42, time.Second},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  channel closed before receiving a value
+channel:
+  (chan int)(%v)
+want:
+  int(42)
+timeout:
+  s"1s"
+`, closedChan),
+}, {
+	about:   "Receives: timeout",
+	checker: qt.Receives(qt.Equals),
+	got:     emptyChan,
+	args:    []interface{}{42, time.Millisecond},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  no value received within 1ms
+channel:
+  (chan int)(%v)
+want:
+  int(42)
+timeout:
+  s"1ms"
+`, emptyChan),
+}, {
+	about:   "ReceivesWithin: timeout",
+	checker: qt.ReceivesWithin(qt.IsTrue, time.Millisecond),
+	got:     emptyChan,
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  no value received within 1ms
+channel:
+  (chan int)(%v)
+`, emptyChan),
+}, {
+	about:   "IsClosed: closed channel",
+	checker: qt.IsClosed,
+	got:     closedChan,
+	expectedNegateFailure: fmt.Sprintf(`
+error:
+  unexpected success
+channel:
+  (chan int)(%v)
+`, closedChan),
+}, {
+	about:   "IsClosed: open channel",
+	checker: qt.IsClosed,
+	got:     emptyChan,
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  channel is not closed
+channel:
+  (chan int)(%v)
+`, emptyChan),
+}, {
+	about:   "IsClosed: send-only channel",
+	checker: qt.IsClosed,
+	got:     sendOnlyChan,
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  bad check: cannot receive from send-only channel
+channel:
+  (chan<- int)(%v)
+`, sendOnlyChan),
+	expectedNegateFailure: fmt.Sprintf(`
+error:
+  bad check: cannot receive from send-only channel
+channel:
+  (chan<- int)(%v)
+`, sendOnlyChan),
+}, {
+	about:   "IsChanEmpty: empty channel",
+	checker: qt.IsChanEmpty,
+	got:     emptyChan,
+	expectedNegateFailure: fmt.Sprintf(`
+error:
+  unexpected success
+channel:
+  (chan int)(%v)
+`, emptyChan),
+}, {
+	about:   "IsChanEmpty: closed channel",
+	checker: qt.IsChanEmpty,
+	got:     closedChan,
+	expectedNegateFailure: fmt.Sprintf(`
+error:
+  unexpected success
+channel:
+  (chan int)(%v)
+`, closedChan),
+}, {
+	about:   "IsChanEmpty: not a channel",
+	checker: qt.IsChanEmpty,
+	got:     []int{},
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a channel
+got:
+  []int{}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a channel
+got:
+  []int{}
+`,
+}}
+
+func TestReceivesSuccess(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan int, 1)
+	ch <- 42
+	ok := c.Check(ch, qt.Receives(qt.Equals), 42, time.Second)
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+	qt.Assert(t, len(ch), qt.Equals, 0)
+}
+
+func TestReceivesZeroTimeoutWithPendingValue(t *testing.T) {
+	// The pending value must be received every time, even if the timer is
+	// already expired.
+	for i := 0; i < 100; i++ {
+		tt := &testingT{}
+		c := qt.New(tt)
+		ch := make(chan int, 1)
+		ch <- 42
+		ok := c.Check(ch, qt.ReceivesWithin(qt.Equals, 0), 42)
+		assertBool(t, ok, true)
+		qt.Assert(t, tt.errorString(), qt.Equals, "")
+	}
+}
+
+func TestReceivesWithinSuccess(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan string)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		ch <- "hello"
+	}()
+	ok := c.Check(ch, qt.ReceivesWithin(qt.Matches, time.Second), "hel+o")
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+}
+
+func TestReceivesFailure(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan []int, 1)
+	ch <- []int{42}
+	ok := c.Check(ch, qt.Receives(qt.DeepEquals), []int{47}, time.Second)
+	assertBool(t, ok, false)
+	qt.Assert(t, tt.errorString(), qt.Matches, `
+error:
+  values are not deep equal
+diff \(-want \+got\):
+(.|\n)*
+got:
+  \[\]int\{42\}
+want:
+  \[\]int\{47\}
+stack:
+(.|\n)*`)
+}
+
+func TestReceivesFailureNotes(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan error, 1)
+	ch <- errors.New("bad wolf")
+	ok := c.Check(ch, qt.ReceivesWithin(qt.ErrorMatches, time.Second), "good.*")
+	assertBool(t, ok, false)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  error does not match regexp
+received value:
+  e"bad wolf"
+regexp:
+  "good.*"
+`)
+}
+
+func TestReceivesNotFailure(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan int, 1)
+	ch <- 42
+	ok := c.Check(ch, qt.Not(qt.Receives(qt.Equals)), 42, time.Second)
+	assertBool(t, ok, false)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  unexpected success
+channel:
+  (chan int)(%v)
+want:
+  int(42)
+timeout:
+  s"1s"
+`, ch))
+}
+
+func TestIsClosedWithPendingValue(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan string, 1)
+	ch <- "bad wolf"
+	ok := c.Check(ch, qt.IsClosed)
+	assertBool(t, ok, false)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  channel is not closed
+buffered values:
+  int(1)
+channel:
+  (chan string)(%v)
+`, ch))
+	qt.Assert(t, len(ch), qt.Equals, 1)
+}
+
+func TestIsChanEmptyWithPendingValues(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ch := make(chan int, 2)
+	ch <- 42
+	ch <- 47
+	ok := c.Check(ch, qt.IsChanEmpty)
+	assertBool(t, ok, false)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  channel is not empty
+buffered values:
+  int(2)
+channel:
+  (chan int)(%v)
+`, ch))
+	qt.Assert(t, len(ch), qt.Equals, 2)
+}
+
+func TestNotIsChanEmptyDoesNotConsume(t *testing.T) {
+	c := qt.New(t)
+	ch := make(chan int, 1)
+	ch <- 42
+	c.Assert(ch, qt.Not(qt.IsChanEmpty))
+	c.Assert(len(ch), qt.Equals, 1)
+}
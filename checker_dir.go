@@ -0,0 +1,300 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rogpeppe/go-internal/txtar"
+)
+
+// DirOption configures how DirEquals compares directory trees.
+type DirOption func(*dirOptions)
+
+type dirOptions struct {
+	ignore       []string
+	compareModes bool
+}
+
+// IgnoreFiles returns a DirOption ignoring the files matching any of the given
+// glob patterns, in the syntax used by path.Match. Patterns including a slash
+// are matched against the slash-separated path of the file relative to the
+// directory, and other patterns against the base name of the file.
+func IgnoreFiles(patterns ...string) DirOption {
+	return func(o *dirOptions) {
+		o.ignore = append(o.ignore, patterns...)
+	}
+}
+
+// CompareFileModes returns a DirOption comparing the permission bits of the
+// regular files, in addition to their contents. When the wanted tree is a txtar
+// archive, only the files with a mode attribute are compared.
+func CompareFileModes() DirOption {
+	return func(o *dirOptions) {
+		o.compareModes = true
+	}
+}
+
+// DirEquals returns a Checker checking that the directory at the provided path
+// includes the same regular files, with the same contents, and the same
+// symbolic links, with the same targets, as the wanted tree. The wanted tree
+// can be provided as a *txtar.Archive, from
+// github.com/rogpeppe/go-internal/txtar, or as the path of another directory.
+// Empty directories are ignored.
+//
+// File names in archives can include the attributes understood by C.MkdirFrom,
+// like "mode=0755" and "-> target", so that an archive used to populate a
+// directory can also be used to check it. Names ending with a slash are
+// ignored.
+//
+// On failure, missing, unexpected and differing files are reported, with line
+// diffs for text files.
+//
+// For instance:
+//
+//	c.Assert(dir, qt.DirEquals(), txtar.Parse([]byte(`
+//	-- go.mod --
+//	module example.com/hello
+//	-- hello.go --
+//	package hello
+//	`)))
+//	c.Assert(dir, qt.DirEquals(qt.IgnoreFiles("*.log"), qt.CompareFileModes()), "testdata/want")
+func DirEquals(opts ...DirOption) Checker {
+	c := &dirEqualsChecker{
+		argNames: []string{"path", "want"},
+	}
+	for _, opt := range opts {
+		opt(&c.opts)
+	}
+	return c
+}
+
+type dirEqualsChecker struct {
+	argNames
+	opts dirOptions
+}
+
+// dirFile holds the contents and mode of a regular file, or the target of a
+// symbolic link, in a directory tree.
+type dirFile struct {
+	data    []byte
+	mode    os.FileMode
+	hasMode bool
+	isLink  bool
+	target  string
+}
+
+// Check implements Checker.Check by checking that the got directory is equal
+// to the args[0] tree.
+func (c *dirEqualsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	root, err := filePath(got, notef)
+	if err != nil {
+		return err
+	}
+	for _, pattern := range c.opts.ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			notef("pattern", pattern)
+			return BadCheckf("invalid ignore pattern: %v", err)
+		}
+	}
+	var wantFiles map[string]dirFile
+	switch want := args[0].(type) {
+	case *txtar.Archive:
+		wantFiles = make(map[string]dirFile, len(want.Files))
+		for _, f := range want.Files {
+			fx, err := parseFixture(f.Name, string(f.Data))
+			if err != nil {
+				notef("file", f.Name)
+				return BadCheckf("invalid file in archive: %v", err)
+			}
+			if fx.isDir || c.ignored(fx.path) {
+				continue
+			}
+			wantFiles[fx.path] = dirFile{
+				data:    f.Data,
+				mode:    fx.mode,
+				hasMode: fx.hasMode,
+				isLink:  fx.target != "",
+				target:  fx.target,
+			}
+		}
+	case string:
+		if wantFiles, err = c.readTree(want); err != nil {
+			notef("want", want)
+			return BadCheckf("cannot read wanted directory: %v", err)
+		}
+	default:
+		notef("want", args[0])
+		return BadCheckf("wanted tree is not a *txtar.Archive or a directory path")
+	}
+	info, err := statFile(root, os.Stat)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.New("file is not a directory")
+	}
+	gotFiles, err := c.readTree(root)
+	if err != nil {
+		return BadCheckf("cannot read directory: %v", err)
+	}
+
+	names := make([]string, 0, len(gotFiles)+len(wantFiles))
+	for name := range gotFiles {
+		names = append(names, name)
+	}
+	for name := range wantFiles {
+		if _, ok := gotFiles[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var notes []note
+	var mismatches []string
+	for _, name := range names {
+		g, inGot := gotFiles[name]
+		w, inWant := wantFiles[name]
+		switch {
+		case !inGot:
+			notes = append(notes, note{"missing file", name})
+		case !inWant:
+			notes = append(notes, note{"unexpected file", name})
+		default:
+			n := len(notes)
+			switch {
+			case g.isLink != w.isLink:
+				notes = append(notes,
+					note{"different file type", name},
+					note{"got type", Unquoted(g.kind())},
+					note{"want type", Unquoted(w.kind())},
+				)
+			case g.isLink:
+				if g.target != w.target {
+					notes = append(notes,
+						note{"different link target", name},
+						note{"got target", g.target},
+						note{"want target", w.target},
+					)
+				}
+			default:
+				if !bytes.Equal(g.data, w.data) {
+					notes = append(notes, note{"different contents", name})
+					if utf8.Valid(g.data) && utf8.Valid(w.data) {
+						notes = append(notes, note{"line diff (-want +got)", Unquoted(lineDiff(string(g.data), string(w.data)))})
+					} else {
+						notes = append(notes, note{"hex diff (-want +got)", Unquoted(hexDiff(g.data, w.data))})
+					}
+				}
+				if c.opts.compareModes && g.hasMode && w.hasMode && g.mode != w.mode {
+					notes = append(notes,
+						note{"different mode", name},
+						note{"got mode", Unquoted(g.mode.String())},
+						note{"want mode", Unquoted(w.mode.String())},
+					)
+				}
+			}
+			if len(notes) == n {
+				continue
+			}
+		}
+		mismatches = append(mismatches, name)
+	}
+	switch len(mismatches) {
+	case 0:
+		return nil
+	case 1:
+		notef("error", Unquoted(fmt.Sprintf("file %s does not match", mismatches[0])))
+	default:
+		notef("error", Unquoted(fmt.Sprintf("%d files do not match", len(mismatches))))
+	}
+	// The wanted tree can be large, so it is not reported in full.
+	notef("path", root)
+	for _, n := range notes {
+		notef(n.key, n.value)
+	}
+	return ErrSilent
+}
+
+// kind returns the description of the file type used in failure reports.
+func (f dirFile) kind() string {
+	if f.isLink {
+		return "symbolic link"
+	}
+	return "regular file"
+}
+
+// readTree returns the regular files and symbolic links found in the
+// directory at the given root, keyed by their slash-separated path relative
+// to root. The root itself can be a symbolic link to the directory.
+func (c *dirEqualsChecker) readTree(root string) (map[string]dirFile, error) {
+	// Walk does not follow a symbolic link provided as root.
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]dirFile)
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		isLink := info.Mode()&os.ModeSymlink != 0
+		if !info.Mode().IsRegular() && !isLink {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if c.ignored(name) {
+			return nil
+		}
+		if isLink {
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			files[name] = dirFile{
+				isLink: true,
+				target: filepath.ToSlash(target),
+			}
+			return nil
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[name] = dirFile{
+			data:    data,
+			mode:    info.Mode().Perm(),
+			hasMode: true,
+		}
+		return nil
+	})
+	return files, err
+}
+
+// ignored reports whether the file with the given slash-separated relative
+// path matches one of the ignore patterns.
+func (c *dirEqualsChecker) ignored(name string) bool {
+	for _, pattern := range c.opts.ignore {
+		target := name
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
+		}
+		// Patterns are validated before reading the trees.
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
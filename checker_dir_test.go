@@ -0,0 +1,303 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/rogpeppe/go-internal/txtar"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDirEqualsArchive(t *testing.T) {
+	c := qt.New(t)
+	dir := writeTree(c, map[string]string{
+		"go.mod":         "module example.com/hello\n",
+		"hello/hello.go": "package hello\n",
+	})
+	tt := &testingT{}
+	ok := qt.New(tt).Check(dir, qt.DirEquals(), txtar.Parse([]byte(`
+-- go.mod --
+module example.com/hello
+-- hello/hello.go --
+package hello
+`)))
+	checkResult(t, ok, tt.errorString(), "")
+}
+
+func TestDirEqualsArchiveFailure(t *testing.T) {
+	c := qt.New(t)
+	dir := writeTree(c, map[string]string{
+		"a.txt":     "hello\nthere\n",
+		"b.txt":     "same\n",
+		"extra.txt": "extra\n",
+	})
+	tt := &testingT{}
+	ok := qt.New(tt).Check(dir, qt.DirEquals(), txtar.Parse([]byte(`
+-- a.txt --
+hello
+world
+-- b.txt --
+same
+-- missing.txt --
+missing
+`)))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  3 files do not match
+path:
+  %q
+different contents:
+  "a.txt"
+line diff (-want +got):
+%s
+unexpected file:
+  "extra.txt"
+missing file:
+  "missing.txt"
+`, dir, diff([]string{"hello\n", "there\n", ""}, []string{"hello\n", "world\n", ""})))
+}
+
+func TestDirEqualsIgnoreFiles(t *testing.T) {
+	c := qt.New(t)
+	dir := writeTree(c, map[string]string{
+		"a.txt":         "a\n",
+		"logs/run.log":  "log\n",
+		"tmp/cache.bin": "cache\n",
+	})
+	tt := &testingT{}
+	ok := qt.New(tt).Check(dir, qt.DirEquals(qt.IgnoreFiles("*.log", "tmp/*")), txtar.Parse([]byte(`
+-- a.txt --
+a
+-- other.log --
+ignored
+`)))
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.DirEquals(qt.IgnoreFiles("[")), &txtar.Archive{})
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: invalid ignore pattern: syntax error in pattern
+pattern:
+  "["
+`)
+}
+
+func TestDirEqualsDir(t *testing.T) {
+	c := qt.New(t)
+	files := map[string]string{
+		"a.txt":     "a\n",
+		"sub/b.bin": "\xff\x00",
+	}
+	got := writeTree(c, files)
+	want := writeTree(c, files)
+	tt := &testingT{}
+	ok := qt.New(tt).Check(got, qt.DirEquals(), want)
+	checkResult(t, ok, tt.errorString(), "")
+
+	writeFile(c, filepath.Join(got, "sub"), "b.bin", "\xff\x01", 0644)
+	tt = &testingT{}
+	ok = qt.New(tt).Check(got, qt.DirEquals(), want)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file sub/b.bin does not match
+path:
+  %q
+different contents:
+  "sub/b.bin"
+hex diff (-want +got):
+%s
+`, got, diff(
+		[]string{"00000000  ff 01                                             |..|"},
+		[]string{"00000000  ff 00                                             |..|"},
+	)))
+}
+
+func TestDirEqualsSymlinkRoot(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symbolic links are not fully supported on windows")
+	}
+	c := qt.New(t)
+	files := map[string]string{
+		"a.txt":     "a\n",
+		"sub/b.txt": "b\n",
+	}
+	dir := writeTree(c, files)
+	got := filepath.Join(c.Mkdir(), "got")
+	c.Assert(os.Symlink(dir, got), qt.IsNil)
+	want := filepath.Join(c.Mkdir(), "want")
+	c.Assert(os.Symlink(writeTree(c, files), want), qt.IsNil)
+	tt := &testingT{}
+	ok := qt.New(tt).Check(got, qt.DirEquals(), want)
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(got, qt.DirEquals(), txtar.Parse([]byte("-- a.txt --\na\n-- sub/b.txt --\nb\n")))
+	checkResult(t, ok, tt.errorString(), "")
+}
+
+func TestDirEqualsCompareFileModes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permission bits are not supported on windows")
+	}
+	c := qt.New(t)
+	files := map[string]string{
+		"run.sh": "#!/bin/sh\n",
+	}
+	got := writeTree(c, files)
+	want := writeTree(c, files)
+	c.Assert(os.Chmod(filepath.Join(want, "run.sh"), 0755), qt.IsNil)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(got, qt.DirEquals(), want)
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(got, qt.DirEquals(qt.CompareFileModes()), want)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file run.sh does not match
+path:
+  %q
+different mode:
+  "run.sh"
+got mode:
+  -rw-r--r--
+want mode:
+  -rwxr-xr-x
+`, got))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(got, qt.DirEquals(qt.CompareFileModes()), txtar.Parse([]byte(`
+-- run.sh mode=0755 --
+#!/bin/sh
+`)))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file run.sh does not match
+path:
+  %q
+different mode:
+  "run.sh"
+got mode:
+  -rw-r--r--
+want mode:
+  -rwxr-xr-x
+`, got))
+
+	// Files without a mode attribute in the archive are not compared.
+	tt = &testingT{}
+	ok = qt.New(tt).Check(got, qt.DirEquals(qt.CompareFileModes()), txtar.Parse([]byte(`
+-- run.sh --
+#!/bin/sh
+`)))
+	checkResult(t, ok, tt.errorString(), "")
+}
+
+func TestDirEqualsMkdirFromArchive(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes and symbolic links are not fully supported on windows")
+	}
+	c := qt.New(t)
+	const archive = `
+-- go.mod --
+module example.com/hello
+-- bin/run.sh mode=0755 --
+#!/bin/sh
+-- current -> bin/run.sh --
+-- cache/ --
+`
+	dir := c.MkdirFrom(archive)
+	tt := &testingT{}
+	ok := qt.New(tt).Check(dir, qt.DirEquals(qt.CompareFileModes()), txtar.Parse([]byte(archive)))
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.DirEquals(), txtar.Parse([]byte(`
+-- go.mod -> go.sum --
+-- bin/run.sh --
+#!/bin/sh
+-- current -> bin --
+`)))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  2 files do not match
+path:
+  %q
+different link target:
+  "current"
+got target:
+  "bin/run.sh"
+want target:
+  "bin"
+different file type:
+  "go.mod"
+got type:
+  regular file
+want type:
+  symbolic link
+`, dir))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.DirEquals(), txtar.Parse([]byte(`
+-- go.mod mode=999 --
+`)))
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: invalid file in archive: invalid mode "mode=999"
+file:
+  "go.mod mode=999"
+`)
+}
+
+func TestDirEqualsErrors(t *testing.T) {
+	c := qt.New(t)
+	dir := c.Mkdir()
+	missing := filepath.Join(dir, "missing")
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(missing, qt.DirEquals(), dir)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file does not exist
+path:
+  %q
+want:
+  %q
+`, missing, dir))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.DirEquals(), 42)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: wanted tree is not a *txtar.Archive or a directory path
+want:
+  int(42)
+`)
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.DirEquals(), missing)
+	assertBool(t, ok, false)
+	c.Assert(tt.errorString(), qt.Matches, `(?s)
+error:
+  bad check: cannot read wanted directory: .*
+`)
+}
+
+// writeTree writes the given files, keyed by their slash-separated path, in a
+// temporary directory and returns its path.
+func writeTree(c *qt.C, files map[string]string) string {
+	dir := c.Mkdir()
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		c.Assert(os.MkdirAll(filepath.Dir(path), 0755), qt.IsNil)
+		writeFile(c, filepath.Dir(path), filepath.Base(path), contents, 0644)
+	}
+	return dir
+}
@@ -5,10 +5,14 @@ package quicktest
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 )
 
 // ErrorAs checks that the error is or wraps a specific error type. If so, it
 // assigns it to the provided pointer. This is analogous to calling errors.As.
+// On failure, the chain of wrapped errors is reported, with the type and
+// message of each error.
 //
 // For instance:
 //
@@ -50,12 +54,70 @@ func (c *errorAsChecker) Check(got interface{}, args []interface{}, note func(ke
 
 	note("error", Unquoted("wanted type is not found in error chain"))
 	note("got", gotErr)
+	if chain, ok := errorChain(gotErr); ok {
+		note("error chain", chain)
+	}
 	note("as", Unquoted(fmt.Sprintf("%T", as)))
 	return ErrSilent
 }
 
+// ErrorAsMatching returns a Checker checking that the error is or wraps a
+// specific error type, like ErrorAs, and that the extracted error satisfies
+// the given checker. The extracted error is also assigned to the provided
+// pointer. The checker arguments, if any, must be provided after the error.
+//
+// On failure, the notes added by the given checker are reported, prefixed
+// with the wanted error type.
+//
+// For instance:
+//
+//	c.Assert(err, qt.ErrorAsMatching(new(*os.PathError), qt.StructMatches(qt.Fields{
+//		"Op":   qt.Bind(qt.Equals, "open"),
+//		"Path": qt.Bind(qt.HasSuffix, "config.json"),
+//	})))
+//	c.Assert(err, qt.ErrorAsMatching(new(*url.Error), qt.Satisfies), (*url.Error).Timeout)
+func ErrorAsMatching(target interface{}, checker Checker) Checker {
+	return &errorAsMatchingChecker{
+		argNames: append([]string{"got"}, checker.ArgNames()[1:]...),
+		target:   target,
+		checker:  checker,
+	}
+}
+
+type errorAsMatchingChecker struct {
+	argNames
+	target  interface{}
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that got is an error whose error
+// chain matches c.target and that the extracted error satisfies c.checker.
+func (c *errorAsMatchingChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	if err := ErrorAs.Check(got, []interface{}{c.target}, notef); err != nil {
+		return err
+	}
+	target := reflect.ValueOf(c.target).Elem()
+	prefix := target.Type().String() + ": "
+	var notes []note
+	err := checkNested(c.checker, target.Interface(), args, "value", func(key string, value interface{}) {
+		notes = append(notes, note{prefix + key, value})
+	})
+	if err == nil {
+		return nil
+	}
+	if err == ErrSilent {
+		notef("error", Unquoted("extracted error does not satisfy checker"))
+		notef("got", got)
+	}
+	for _, n := range notes {
+		notef(n.key, n.value)
+	}
+	return err
+}
+
 // ErrorIs checks that the error is or wraps a specific error value. This is
-// analogous to calling errors.Is.
+// analogous to calling errors.Is. On failure, the chain of wrapped errors is
+// reported, as with ErrorAs.
 //
 // For instance:
 //
@@ -86,7 +148,188 @@ func (c *errorIsChecker) Check(got interface{}, args []interface{}, note func(ke
 	}
 
 	if !errors.Is(gotErr, wantErr) {
+		if chain, ok := errorChain(gotErr); ok {
+			note("error chain", chain)
+		}
 		return errors.New("wanted error is not found in error chain")
 	}
 	return nil
 }
+
+// ErrorIsAll returns a Checker checking that the error is or wraps all the
+// given error values. The whole error tree is walked, including the errors
+// wrapped with Unwrap() []error methods, like the ones returned by
+// errors.Join. On failure, the missing errors are reported.
+//
+// For instance:
+//
+//	c.Assert(err, qt.ErrorIsAll(ErrMissingName, ErrInvalidEmail))
+func ErrorIsAll(targets ...error) Checker {
+	return &errorTreeChecker{
+		argNames: []string{"got"},
+		targets:  targets,
+	}
+}
+
+// ErrorsExactly returns a Checker checking that the error tree contains
+// exactly the given error values and nothing else. The whole error tree is
+// walked as with ErrorIsAll: each wanted error must be found in the tree, and
+// each leaf error which is not wrapped by a wanted error is unexpected. On
+// failure, the missing and unexpected errors are reported separately.
+//
+// For instance:
+//
+//	c.Assert(err, qt.ErrorsExactly(ErrMissingName, ErrInvalidEmail))
+func ErrorsExactly(targets ...error) Checker {
+	return &errorTreeChecker{
+		argNames: []string{"got"},
+		targets:  targets,
+		exactly:  true,
+	}
+}
+
+type errorTreeChecker struct {
+	argNames
+	targets []error
+	exactly bool
+}
+
+// Check implements Checker.Check by checking that the error tree of got
+// contains c.targets, and nothing else if c.exactly is true.
+func (c *errorTreeChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	if err := checkFirstArgIsError(got, note); err != nil {
+		return err
+	}
+	for i, target := range c.targets {
+		if target == nil {
+			return BadCheckf("wanted error at index %d is nil", i)
+		}
+	}
+	gotErr := got.(error)
+	found := make([]bool, len(c.targets))
+	var unexpected []error
+	walkErrorTree(gotErr, 0, func(err error) bool {
+		matched := false
+		for i, target := range c.targets {
+			if isError(err, target) {
+				found[i] = true
+				matched = true
+			}
+		}
+		if !matched && len(unwrapErrors(err)) == 0 {
+			unexpected = append(unexpected, err)
+		}
+		// Do not look into the errors wrapped by a wanted error.
+		return !matched
+	})
+	var missing []error
+	for i, target := range c.targets {
+		if !found[i] {
+			missing = append(missing, target)
+		}
+	}
+	if !c.exactly {
+		unexpected = nil
+	}
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return nil
+	}
+	if len(missing) != 0 {
+		note("missing", errorList(missing))
+	}
+	if len(unexpected) != 0 {
+		note("unexpected", errorList(unexpected))
+	}
+	if chain, ok := errorChain(gotErr); ok {
+		note("error chain", chain)
+	}
+	switch {
+	case c.exactly:
+		return errors.New("error tree does not contain exactly the wanted errors")
+	case len(missing) == 1:
+		return errors.New("wanted error is not found in error chain")
+	}
+	return errors.New("wanted errors are not found in error chain")
+}
+
+// walkErrorTree calls f for the given error and, if f returns true, for the
+// errors it wraps, recursively.
+func walkErrorTree(err error, depth int, f func(error) bool) {
+	if depth == maxErrorChainDepth || !f(err) {
+		return
+	}
+	for _, e := range unwrapErrors(err) {
+		walkErrorTree(e, depth+1, f)
+	}
+}
+
+// isError reports whether the given error matches the target, without
+// unwrapping it. This is equivalent to a single step of errors.Is.
+func isError(err, target error) bool {
+	if reflect.TypeOf(target).Comparable() && err == target {
+		return true
+	}
+	if x, ok := err.(interface{ Is(error) bool }); ok {
+		return x.Is(target)
+	}
+	return false
+}
+
+// errorList returns the representation of the given errors used in reports,
+// with one error per line.
+func errorList(errs []error) Unquoted {
+	lines := make([]string, len(errs))
+	for i, err := range errs {
+		lines[i] = Format(err)
+	}
+	return Unquoted(strings.Join(lines, "\n"))
+}
+
+// maxErrorChainDepth holds the maximum depth of the error chains reported by
+// errorChain, protecting against errors wrapping themselves.
+const maxErrorChainDepth = 100
+
+// errorChain returns a representation of the tree of errors wrapped by the
+// given error, showing the type and message of each error, and reports
+// whether the error wraps other errors. Both Unwrap() error and
+// Unwrap() []error methods are supported.
+func errorChain(err error) (Unquoted, bool) {
+	if len(unwrapErrors(err)) == 0 {
+		return "", false
+	}
+	var buf strings.Builder
+	writeErrorChain(&buf, err, 0)
+	return Unquoted(strings.TrimSuffix(buf.String(), "\n")), true
+}
+
+// writeErrorChain writes the given error and the errors it wraps to buf,
+// indented according to the given depth.
+func writeErrorChain(buf *strings.Builder, err error, depth int) {
+	buf.WriteString(strings.Repeat("  ", depth))
+	if depth == maxErrorChainDepth {
+		buf.WriteString("...\n")
+		return
+	}
+	fmt.Fprintf(buf, "%T: %q\n", err, err.Error())
+	for _, e := range unwrapErrors(err) {
+		writeErrorChain(buf, e, depth+1)
+	}
+}
+
+// unwrapErrors returns the non-nil errors directly wrapped by the given error.
+func unwrapErrors(err error) []error {
+	var errs []error
+	switch err := err.(type) {
+	case interface{ Unwrap() error }:
+		errs = []error{err.Unwrap()}
+	case interface{ Unwrap() []error }:
+		errs = err.Unwrap()
+	}
+	var wrapped []error
+	for _, e := range errs {
+		if e != nil {
+			wrapped = append(wrapped, e)
+		}
+	}
+	return wrapped
+}
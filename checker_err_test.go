@@ -5,6 +5,7 @@ package quicktest_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	qt "github.com/frankban/quicktest"
 )
@@ -31,6 +32,48 @@ func (e errTargetNonPtr) Error() string {
 
 var targetErr = &errTarget{msg: "target"}
 
+var (
+	errFirst  = errors.New("first")
+	errSecond = errors.New("second")
+	errThird  = errors.New("third")
+)
+
+// errMulti is an error wrapping multiple errors, like the ones returned by
+// errors.Join.
+type errMulti []error
+
+func (e errMulti) Error() string {
+	var msgs []string
+	for _, err := range e {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e errMulti) Unwrap() []error {
+	return e
+}
+
+// errIs is an error wrapping another error and matching a target error.
+type errIs struct {
+	target error
+	err    error
+}
+
+func (e errIs) Error() string {
+	return "is " + e.target.Error() + ": " + e.err.Error()
+}
+
+func (e errIs) Is(target error) bool {
+	return target == e.target
+}
+
+func (e errIs) Unwrap() error {
+	return e.err
+}
+
 var errorCheckerTests = []struct {
 	about                 string
 	checker               qt.Checker
@@ -104,6 +147,22 @@ got:
 as:
   *quicktest_test.errTargetNonPtr
 `,
+}, {
+	about:   "ErrorAs: fails if mismatch with wrapped error",
+	checker: qt.ErrorAs,
+	got:     fmt.Errorf("wrapped: %w", errTargetNonPtr{msg: "target"}),
+	args:    []interface{}{new(*errTarget)},
+	expectedCheckFailure: `
+error:
+  wanted type is not found in error chain
+got:
+  e"wrapped: non ptr: target"
+error chain:
+  *fmt.wrapError: "wrapped: non ptr: target"
+    quicktest_test.errTargetNonPtr: "non ptr: target"
+as:
+  **quicktest_test.errTarget
+`,
 }, {
 	about:   "ErrorAs: bad check if invalid error",
 	checker: qt.ErrorAs,
@@ -134,6 +193,283 @@ error:
 error:
   bad check: errors: *target must be interface or implement error
 `,
+}, {
+	about:   "ErrorAsMatching: success",
+	checker: qt.ErrorAsMatching(new(*errTarget), qt.StructMatches(nil)),
+	got:     fmt.Errorf("wrapped: %w", targetErr),
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"wrapped: ptr: target"
+`,
+}, {
+	about:   "ErrorAsMatching: success with arguments",
+	checker: qt.ErrorAsMatching(new(errTargetNonPtr), qt.Equals),
+	got:     fmt.Errorf("wrapped: %w", errTargetNonPtr{msg: "target"}),
+	args:    []interface{}{errTargetNonPtr{msg: "target"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"wrapped: non ptr: target"
+want:
+  e"non ptr: target"
+`,
+}, {
+	about:   "ErrorAsMatching: checker failure",
+	checker: qt.ErrorAsMatching(new(errTargetNonPtr), qt.Equals),
+	got:     fmt.Errorf("wrapped: %w", errTargetNonPtr{msg: "target"}),
+	args:    []interface{}{errTargetNonPtr{msg: "other"}},
+	expectedCheckFailure: `
+error:
+  extracted error does not satisfy checker
+got:
+  e"wrapped: non ptr: target"
+quicktest_test.errTargetNonPtr: error:
+  values are not equal
+quicktest_test.errTargetNonPtr: value:
+  e"non ptr: target"
+quicktest_test.errTargetNonPtr: want:
+  e"non ptr: other"
+`,
+}, {
+	about:   "ErrorAsMatching: nested notes",
+	checker: qt.ErrorAsMatching(new(*errTarget), qt.Satisfies),
+	got:     fmt.Errorf("wrapped: %w", targetErr),
+	args: []interface{}{func(err *errTarget) bool {
+		return err.msg == "other"
+	}},
+	expectedCheckFailure: `
+error:
+  extracted error does not satisfy checker
+got:
+  e"wrapped: ptr: target"
+*quicktest_test.errTarget: error:
+  value does not satisfy predicate function
+*quicktest_test.errTarget: value:
+  e"ptr: target"
+*quicktest_test.errTarget: predicate function:
+  func(*quicktest_test.errTarget) bool {...}
+`,
+}, {
+	about:   "ErrorAsMatching: interface target",
+	checker: qt.ErrorAsMatching(new(interface{ Unwrap() error }), qt.ErrorMatches),
+	got:     fmt.Errorf("wrapped: %w", targetErr),
+	args:    []interface{}{"other"},
+	expectedCheckFailure: `
+error:
+  extracted error does not satisfy checker
+got:
+  e"wrapped: ptr: target"
+interface { Unwrap() error }: error:
+  error does not match regexp
+interface { Unwrap() error }: value:
+  <same as "got">
+interface { Unwrap() error }: regexp:
+  "other"
+`,
+}, {
+	about:   "ErrorAsMatching: type not found",
+	checker: qt.ErrorAsMatching(new(*errTarget), qt.IsNotNil),
+	got:     errors.New("other error"),
+	expectedCheckFailure: `
+error:
+  wanted type is not found in error chain
+got:
+  e"other error"
+as:
+  **quicktest_test.errTarget
+`,
+}, {
+	about:   "ErrorAsMatching: nested bad check",
+	checker: qt.ErrorAsMatching(new(*errTarget), qt.HasLen),
+	got:     targetErr,
+	args:    []interface{}{"bad"},
+	expectedCheckFailure: `
+error:
+  bad check: first argument has no length
+*quicktest_test.errTarget: got:
+  e"ptr: target"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument has no length
+*quicktest_test.errTarget: got:
+  e"ptr: target"
+`,
+}, {
+	about:   "ErrorAsMatching: not enough arguments",
+	checker: qt.ErrorAsMatching(new(*errTarget), qt.Equals),
+	got:     targetErr,
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+}, {
+	about:   "ErrorIsAll: success",
+	checker: qt.ErrorIsAll(errFirst, targetErr),
+	got: fmt.Errorf("validation: %w", errMulti{
+		fmt.Errorf("name: %w", errFirst),
+		errSecond,
+		fmt.Errorf("wrapped: %w", targetErr),
+	}),
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"validation: name: first\nsecond\nwrapped: ptr: target"
+`,
+}, {
+	about:   "ErrorIsAll: single error",
+	checker: qt.ErrorIsAll(targetErr),
+	got:     targetErr,
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"ptr: target"
+`,
+}, {
+	about:   "ErrorIsAll: missing error",
+	checker: qt.ErrorIsAll(errFirst, errThird),
+	got:     errMulti{errFirst, errSecond},
+	expectedCheckFailure: `
+error:
+  wanted error is not found in error chain
+missing:
+  e"third"
+error chain:
+  quicktest_test.errMulti: "first\nsecond"
+    *errors.errorString: "first"
+    *errors.errorString: "second"
+got:
+  e"first\nsecond"
+`,
+}, {
+	about:   "ErrorIsAll: missing errors",
+	checker: qt.ErrorIsAll(errSecond, errThird),
+	got:     errFirst,
+	expectedCheckFailure: `
+error:
+  wanted errors are not found in error chain
+missing:
+  e"second"
+  e"third"
+got:
+  e"first"
+`,
+}, {
+	about:   "ErrorIsAll: nil error",
+	checker: qt.ErrorIsAll(errFirst),
+	got:     nil,
+	expectedCheckFailure: `
+error:
+  got nil error but want non-nil
+got:
+  nil
+`,
+}, {
+	about:   "ErrorIsAll: nil target",
+	checker: qt.ErrorIsAll(errFirst, nil),
+	got:     errFirst,
+	expectedCheckFailure: `
+error:
+  bad check: wanted error at index 1 is nil
+`,
+	expectedNegateFailure: `
+error:
+  bad check: wanted error at index 1 is nil
+`,
+}, {
+	about:   "ErrorsExactly: success",
+	checker: qt.ErrorsExactly(errSecond, errFirst, targetErr),
+	got: fmt.Errorf("validation: %w", errMulti{
+		fmt.Errorf("name: %w", errFirst),
+		errSecond,
+		fmt.Errorf("wrapped: %w", targetErr),
+	}),
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"validation: name: first\nsecond\nwrapped: ptr: target"
+`,
+}, {
+	about:   "ErrorsExactly: wanted error wrapping other errors",
+	checker: qt.ErrorsExactly(errFirst),
+	got: fmt.Errorf("validation: %w", errMulti{
+		errIs{target: errFirst, err: errSecond},
+	}),
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  e"validation: is first: second"
+`,
+}, {
+	about:   "ErrorsExactly: missing and unexpected errors",
+	checker: qt.ErrorsExactly(errFirst, errThird),
+	got: fmt.Errorf("validation: %w", errMulti{
+		fmt.Errorf("name: %w", errFirst),
+		errSecond,
+		fmt.Errorf("wrapped: %w", targetErr),
+	}),
+	expectedCheckFailure: `
+error:
+  error tree does not contain exactly the wanted errors
+missing:
+  e"third"
+unexpected:
+  e"second"
+  e"ptr: target"
+error chain:
+  *fmt.wrapError: "validation: name: first\nsecond\nwrapped: ptr: target"
+    quicktest_test.errMulti: "name: first\nsecond\nwrapped: ptr: target"
+      *fmt.wrapError: "name: first"
+        *errors.errorString: "first"
+      *errors.errorString: "second"
+      *fmt.wrapError: "wrapped: ptr: target"
+        *quicktest_test.errTarget: "ptr: target"
+got:
+  e"validation: name: first\nsecond\nwrapped: ptr: target"
+`,
+}, {
+	about:   "ErrorsExactly: unexpected error",
+	checker: qt.ErrorsExactly(),
+	got:     errFirst,
+	expectedCheckFailure: `
+error:
+  error tree does not contain exactly the wanted errors
+unexpected:
+  e"first"
+got:
+  <same as "unexpected">
+`,
+}, {
+	about:   "ErrorsExactly: bad check if invalid error",
+	checker: qt.ErrorsExactly(errFirst),
+	got:     "not an error",
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not an error
+got:
+  "not an error"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not an error
+got:
+  "not an error"
+`,
 }, {
 	about:   "ErrorIs: nil to nil match",
 	checker: qt.ErrorIs,
@@ -212,6 +548,46 @@ got:
 want:
   e"ptr: target"
 `,
+}, {
+	about:   "ErrorIs: fails if mismatch with wrapped error",
+	checker: qt.ErrorIs,
+	got:     fmt.Errorf("cannot load: %w", fmt.Errorf("wrapped: %w", targetErr)),
+	args:    []interface{}{errors.New("other error")},
+	expectedCheckFailure: `
+error:
+  wanted error is not found in error chain
+error chain:
+  *fmt.wrapError: "cannot load: wrapped: ptr: target"
+    *fmt.wrapError: "wrapped: ptr: target"
+      *quicktest_test.errTarget: "ptr: target"
+got:
+  e"cannot load: wrapped: ptr: target"
+want:
+  e"other error"
+`,
+}, {
+	about:   "ErrorIs: fails if mismatch with error tree",
+	checker: qt.ErrorIs,
+	got: fmt.Errorf("cannot load: %w", errMulti{
+		errors.New("first"),
+		fmt.Errorf("second: %w", targetErr),
+		nil,
+	}),
+	args: []interface{}{errors.New("other error")},
+	expectedCheckFailure: `
+error:
+  wanted error is not found in error chain
+error chain:
+  *fmt.wrapError: "cannot load: first\nsecond: ptr: target"
+    quicktest_test.errMulti: "first\nsecond: ptr: target"
+      *errors.errorString: "first"
+      *fmt.wrapError: "second: ptr: target"
+        *quicktest_test.errTarget: "ptr: target"
+got:
+  e"cannot load: first\nsecond: ptr: target"
+want:
+  e"other error"
+`,
 }, {
 	about:   "ErrorIs: bad check if invalid error",
 	checker: qt.ErrorIs,
@@ -0,0 +1,224 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+)
+
+// FileExists is a Checker checking that a file exists at the provided path.
+// Symbolic links are not followed, so a link to a missing file exists.
+//
+// For instance:
+//
+//	c.Assert(filepath.Join(dir, "config.yaml"), qt.FileExists)
+var FileExists Checker = &fileExistsChecker{
+	argNames: []string{"path"},
+}
+
+type fileExistsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the got path exists.
+func (c *fileExistsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	path, err := filePath(got, note)
+	if err != nil {
+		return err
+	}
+	_, err = statFile(path, os.Lstat)
+	return err
+}
+
+// IsDir is a Checker checking that the provided path is a directory.
+// Symbolic links are followed.
+//
+// For instance:
+//
+//	c.Assert(filepath.Join(dir, "cache"), qt.IsDir)
+var IsDir Checker = &isDirChecker{
+	argNames: []string{"path"},
+}
+
+type isDirChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the got path is a
+// directory.
+func (c *isDirChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	path, err := filePath(got, note)
+	if err != nil {
+		return err
+	}
+	info, err := statFile(path, os.Stat)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return nil
+	}
+	note("mode", Unquoted(info.Mode().String()))
+	return errors.New("file is not a directory")
+}
+
+// FileMode is a Checker checking that the permission bits of the file at the
+// provided path are equal to the given ones. The wanted permissions can be
+// provided as an os.FileMode or as an integer. Symbolic links are followed.
+//
+// For instance:
+//
+//	c.Assert(filepath.Join(dir, "id_rsa"), qt.FileMode, 0600)
+var FileMode Checker = &fileModeChecker{
+	argNames: []string{"path", "mode"},
+}
+
+type fileModeChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the permission bits of the
+// got path are equal to args[0].
+func (c *fileModeChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	path, err := filePath(got, note)
+	if err != nil {
+		return err
+	}
+	var want os.FileMode
+	switch v := reflect.ValueOf(args[0]); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		want = os.FileMode(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		want = os.FileMode(v.Uint())
+	default:
+		note("mode", args[0])
+		return BadCheckf("mode is not an os.FileMode or an integer")
+	}
+	if want != want.Perm() {
+		note("mode", args[0])
+		return BadCheckf("mode includes bits other than the permission bits")
+	}
+	info, err := statFile(path, os.Stat)
+	if err != nil {
+		return err
+	}
+	if perm := info.Mode().Perm(); perm != want {
+		note("got mode", Unquoted(fmt.Sprintf("%#o (%s)", uint32(perm), perm)))
+		note("want mode", Unquoted(fmt.Sprintf("%#o (%s)", uint32(want), want)))
+		return errors.New("file permissions do not match")
+	}
+	return nil
+}
+
+// LinksTo is a Checker checking that the provided path is a symbolic link
+// whose target is equal to the given string. The target is compared as
+// stored in the link, without resolving it.
+//
+// For instance:
+//
+//	c.Assert(filepath.Join(dir, "current"), qt.LinksTo, "releases/v2")
+var LinksTo Checker = &linksToChecker{
+	argNames: []string{"path", "target"},
+}
+
+type linksToChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the got path is a symbolic
+// link to args[0].
+func (c *linksToChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	path, err := filePath(got, note)
+	if err != nil {
+		return err
+	}
+	want, ok := args[0].(string)
+	if !ok {
+		note("target", args[0])
+		return BadCheckf("target is not a string")
+	}
+	info, err := statFile(path, os.Lstat)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		note("mode", Unquoted(info.Mode().String()))
+		return errors.New("file is not a symbolic link")
+	}
+	target, err := os.Readlink(path)
+	if err != nil {
+		return BadCheckf("cannot read symbolic link: %v", err)
+	}
+	if target != want {
+		note("got target", target)
+		return errors.New("symbolic link target does not match")
+	}
+	return nil
+}
+
+// FileContents returns a Checker checking that the contents of the file at
+// the provided path satisfy the given checker. The contents are passed to the
+// checker as a string. The checker arguments, if any, must be provided after
+// the path.
+//
+// For instance:
+//
+//	c.Assert(filepath.Join(dir, "out.txt"), qt.FileContents(qt.Equals), "hello\n")
+//	c.Assert(filepath.Join(dir, "out.json"), qt.FileContents(qt.JSONEquals), want)
+func FileContents(checker Checker) Checker {
+	return &fileContentsChecker{
+		argNames: append([]string{"path"}, checker.ArgNames()[1:]...),
+		checker:  checker,
+	}
+}
+
+type fileContentsChecker struct {
+	argNames
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that the contents of the got
+// path satisfy c.checker.
+func (c *fileContentsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	path, err := filePath(got, notef)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return errors.New("file does not exist")
+	}
+	if err != nil {
+		return BadCheckf("cannot read file: %v", err)
+	}
+	return checkNested(c.checker, string(data), args, "contents", notef, note{"path", path})
+}
+
+// filePath returns the path provided as the got value of a filesystem
+// checker, or a BadCheck error if got is not a string.
+func filePath(got interface{}, note func(key string, value interface{})) (string, error) {
+	path, ok := got.(string)
+	if !ok {
+		note("path", got)
+		return "", BadCheckf("first argument is not a string")
+	}
+	return path, nil
+}
+
+// statFile returns the file information for the given path, using the given
+// stat function. A missing file is reported as a regular failure, and other
+// errors as BadCheck errors.
+func statFile(path string, stat func(string) (os.FileInfo, error)) (os.FileInfo, error) {
+	info, err := stat(path)
+	if os.IsNotExist(err) {
+		return nil, errors.New("file does not exist")
+	}
+	if err != nil {
+		return nil, BadCheckf("cannot stat file: %v", err)
+	}
+	return info, nil
+}
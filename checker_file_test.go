@@ -0,0 +1,245 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestFileExists(t *testing.T) {
+	c := qt.New(t)
+	dir := c.Mkdir()
+	path := writeFile(c, dir, "file.txt", "hello", 0644)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(path, qt.FileExists)
+	checkResult(t, ok, tt.errorString(), "")
+
+	missing := filepath.Join(dir, "missing.txt")
+	tt = &testingT{}
+	ok = qt.New(tt).Check(missing, qt.FileExists)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file does not exist
+path:
+  %q
+`, missing))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.Not(qt.FileExists))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  unexpected success
+path:
+  %q
+`, path))
+}
+
+func TestFileExistsBadType(t *testing.T) {
+	tt := &testingT{}
+	ok := qt.New(tt).Check(42, qt.FileExists)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: first argument is not a string
+path:
+  int(42)
+`)
+}
+
+func TestIsDir(t *testing.T) {
+	c := qt.New(t)
+	dir := c.Mkdir()
+	path := writeFile(c, dir, "file.txt", "hello", 0644)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(dir, qt.IsDir)
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.IsDir)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file is not a directory
+mode:
+  -rw-r--r--
+path:
+  %q
+`, path))
+
+	missing := filepath.Join(dir, "missing")
+	tt = &testingT{}
+	ok = qt.New(tt).Check(missing, qt.IsDir)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file does not exist
+path:
+  %q
+`, missing))
+}
+
+func TestFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permission bits are not supported on windows")
+	}
+	c := qt.New(t)
+	path := writeFile(c, c.Mkdir(), "id_rsa", "secret", 0600)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(path, qt.FileMode, 0600)
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.FileMode, os.FileMode(0600))
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.FileMode, 0644)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file permissions do not match
+got mode:
+  0600 (-rw-------)
+want mode:
+  0644 (-rw-r--r--)
+path:
+  %q
+mode:
+  int(420)
+`, path))
+}
+
+func TestFileModeBadMode(t *testing.T) {
+	tt := &testingT{}
+	ok := qt.New(tt).Check("path", qt.FileMode, "0644")
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: mode is not an os.FileMode or an integer
+mode:
+  "0644"
+`)
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check("path", qt.FileMode, os.ModeDir|0755)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: mode includes bits other than the permission bits
+mode:
+  s"drwxr-xr-x"
+`)
+}
+
+func TestLinksTo(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symbolic links are not always supported on windows")
+	}
+	c := qt.New(t)
+	dir := c.Mkdir()
+	path := writeFile(c, dir, "file.txt", "hello", 0644)
+	link := filepath.Join(dir, "link")
+	c.Assert(os.Symlink("file.txt", link), qt.IsNil)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(link, qt.LinksTo, "file.txt")
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(link, qt.LinksTo, "other.txt")
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  symbolic link target does not match
+got target:
+  "file.txt"
+path:
+  %q
+target:
+  "other.txt"
+`, link))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.LinksTo, "file.txt")
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file is not a symbolic link
+mode:
+  -rw-r--r--
+path:
+  %q
+target:
+  "file.txt"
+`, path))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(link, qt.LinksTo, 42)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: target is not a string
+target:
+  int(42)
+`)
+}
+
+func TestFileContents(t *testing.T) {
+	c := qt.New(t)
+	dir := c.Mkdir()
+	path := writeFile(c, dir, "out.json", `{"name": "bob"}`, 0644)
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(path, qt.FileContents(qt.JSONEquals), map[string]string{"name": "bob"})
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(path, qt.FileContents(qt.Matches), `\{.*"alice".*\}`)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  value does not match regexp
+path:
+  %q
+contents:
+  %s
+regexp:
+  %s
+`, path, "`{\"name\": \"bob\"}`", "`\\{.*\"alice\".*\\}`"))
+
+	missing := filepath.Join(dir, "missing.txt")
+	tt = &testingT{}
+	ok = qt.New(tt).Check(missing, qt.FileContents(qt.Equals), "")
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  file does not exist
+path:
+  %q
+want:
+  ""
+`, missing))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(dir, qt.FileContents(qt.Equals), "")
+	assertBool(t, ok, false)
+	c.Assert(tt.errorString(), qt.Matches, `(?s)
+error:
+  bad check: cannot read file: .*
+`)
+}
+
+func TestFileContentsArgNames(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(qt.FileContents(qt.Equals).ArgNames(), qt.DeepEquals, []string{"path", "want"})
+	c.Assert(qt.FileContents(qt.IsTrue).ArgNames(), qt.DeepEquals, []string{"path"})
+}
+
+// writeFile writes a file with the given name, contents and permissions in
+// the given directory and returns its path.
+func writeFile(c *qt.C, dir, name, contents string, perm os.FileMode) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, []byte(contents), perm)
+	c.Assert(err, qt.IsNil)
+	c.Assert(os.Chmod(path, perm), qt.IsNil)
+	return path
+}
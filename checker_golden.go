@@ -0,0 +1,107 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// updateFlag holds whether golden files and inline snapshots must be updated
+// rather than compared.
+var updateFlag = flag.Bool("qt.update", false, "update the golden files and inline snapshots used by qt.GoldenEquals and qt.InlineEquals")
+
+// updating reports whether golden files and inline snapshots must be updated,
+// because the -qt.update flag is set or the QT_UPDATE environment variable is
+// set to "1".
+func updating() bool {
+	return *updateFlag || os.Getenv("QT_UPDATE") == "1"
+}
+
+// GoldenEquals returns a Checker checking that the provided string or byte
+// slice is equal to the contents of the golden file at the given path.
+//
+// When the tests are run with the -qt.update flag, or with the QT_UPDATE
+// environment variable set to "1", the golden file is written with the
+// provided contents instead, creating its directory if required.
+//
+// On failure, a line diff is reported for text contents, and a diff of the
+// hexadecimal dumps for binary contents.
+//
+// For instance:
+//
+//	c.Assert(output, qt.GoldenEquals("testdata/output.golden"))
+func GoldenEquals(path string) Checker {
+	return &goldenEqualsChecker{
+		argNames: []string{"got"},
+		path:     path,
+	}
+}
+
+type goldenEqualsChecker struct {
+	argNames
+	path string
+}
+
+// Check implements Checker.Check by checking that got is equal to the
+// contents of the c.path golden file, or by writing the file in update mode.
+func (c *goldenEqualsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	var gotData []byte
+	switch got := got.(type) {
+	case string:
+		gotData = []byte(got)
+	case []byte:
+		gotData = got
+	default:
+		note("got", got)
+		return BadCheckf("first argument is not a string or a []byte")
+	}
+	if updating() {
+		if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+			return BadCheckf("cannot update golden file: %v", err)
+		}
+		if err := ioutil.WriteFile(c.path, gotData, 0644); err != nil {
+			return BadCheckf("cannot update golden file: %v", err)
+		}
+		return nil
+	}
+	wantData, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		note("golden file", c.path)
+		return BadCheckf("golden file does not exist: run the tests with -qt.update or QT_UPDATE=1 to create it")
+	}
+	if err != nil {
+		note("golden file", c.path)
+		return BadCheckf("cannot read golden file: %v", err)
+	}
+	if bytes.Equal(gotData, wantData) {
+		return nil
+	}
+
+	// The contents can be large, so they are not reported in full.
+	note("error", Unquoted("value does not match golden file"))
+	note("golden file", c.path)
+	if utf8.Valid(gotData) && utf8.Valid(wantData) {
+		note("line diff (-want +got)", Unquoted(lineDiff(string(gotData), string(wantData))))
+	} else {
+		note("hex diff (-want +got)", Unquoted(hexDiff(gotData, wantData)))
+	}
+	return ErrSilent
+}
+
+// hexDiff returns the diff between the hexadecimal dumps of the given byte
+// slices.
+func hexDiff(got, want []byte) string {
+	dump := func(data []byte) []string {
+		return strings.SplitAfter(strings.TrimSuffix(hex.Dump(data), "\n"), "\n")
+	}
+	return cmp.Diff(dump(want), dump(got))
+}
@@ -0,0 +1,116 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestGoldenEqualsSuccess(t *testing.T) {
+	c := qt.New(t)
+	path := writeGolden(c, "hello\nworld\n")
+	tt := &testingT{}
+	ok := qt.New(tt).Check([]byte("hello\nworld\n"), qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), "")
+}
+
+func TestGoldenEqualsTextFailure(t *testing.T) {
+	c := qt.New(t)
+	path := writeGolden(c, "hello\nworld\n")
+	tt := &testingT{}
+	ok := qt.New(tt).Check("hello\nthere\n", qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  value does not match golden file
+golden file:
+  %q
+line diff (-want +got):
+%s
+`, path, diff([]string{"hello\n", "there\n", ""}, []string{"hello\n", "world\n", ""})))
+}
+
+func TestGoldenEqualsBinaryFailure(t *testing.T) {
+	c := qt.New(t)
+	want := []byte{0xff, 0xfe, 0, 1}
+	path := writeGolden(c, string(want))
+	got := []byte{0xff, 0xfe, 0, 2}
+	tt := &testingT{}
+	ok := qt.New(tt).Check(got, qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  value does not match golden file
+golden file:
+  %q
+hex diff (-want +got):
+%s
+`, path, diff(
+		[]string{strings.TrimSuffix(hex.Dump(got), "\n")},
+		[]string{strings.TrimSuffix(hex.Dump(want), "\n")},
+	)))
+}
+
+func TestGoldenEqualsMissingFile(t *testing.T) {
+	c := qt.New(t)
+	path := filepath.Join(c.Mkdir(), "missing.golden")
+	tt := &testingT{}
+	ok := qt.New(tt).Check("hello", qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  bad check: golden file does not exist: run the tests with -qt.update or QT_UPDATE=1 to create it
+golden file:
+  %q
+`, path))
+}
+
+func TestGoldenEqualsBadType(t *testing.T) {
+	tt := &testingT{}
+	ok := qt.New(tt).Check(42, qt.GoldenEquals("testdata/output.golden"))
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: first argument is not a string or a []byte
+got:
+  int(42)
+`)
+}
+
+func TestGoldenEqualsUpdateEnv(t *testing.T) {
+	c := qt.New(t)
+	c.Setenv("QT_UPDATE", "1")
+	path := filepath.Join(c.Mkdir(), "testdata", "output.golden")
+	tt := &testingT{}
+	ok := qt.New(tt).Check("hello\n", qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), "")
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, "hello\n")
+}
+
+func TestGoldenEqualsUpdateFlag(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(flag.Set("qt.update", "true"), qt.IsNil)
+	defer flag.Set("qt.update", "false")
+	path := writeGolden(c, "old contents")
+	tt := &testingT{}
+	ok := qt.New(tt).Check("new contents", qt.GoldenEquals(path))
+	checkResult(t, ok, tt.errorString(), "")
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, "new contents")
+}
+
+// writeGolden writes a golden file with the given contents in a temporary
+// directory and returns its path.
+func writeGolden(c *qt.C, contents string) string {
+	path := filepath.Join(c.Mkdir(), "output.golden")
+	err := ioutil.WriteFile(path, []byte(contents), 0644)
+	c.Assert(err, qt.IsNil)
+	return path
+}
@@ -0,0 +1,295 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxReportedBodyLen holds the maximum number of bytes of a response body
+// included in failure reports.
+const maxReportedBodyLen = 1024
+
+// StatusEquals is a Checker checking that the status code of an HTTP
+// response is equal to the given one. The response can be provided as an
+// *http.Response or as an *httptest.ResponseRecorder.
+//
+// On failure, the request method and URL, when available, and the response
+// status, headers and body are reported. The body is buffered, so that it can
+// still be read after the check.
+//
+// For instance:
+//
+//	c.Assert(rec, qt.StatusEquals, http.StatusNotFound)
+var StatusEquals Checker = &statusEqualsChecker{
+	argNames: []string{"response", "status"},
+}
+
+type statusEqualsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the status code of the got
+// response is equal to args[0].
+func (c *statusEqualsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	want, ok := args[0].(int)
+	if !ok {
+		notef("status", args[0])
+		return BadCheckf("status is not an int")
+	}
+	r, err := httpResponse(got, notef)
+	if err != nil {
+		return err
+	}
+	if r.status == want {
+		return nil
+	}
+	notef("error", Unquoted("status codes are not equal"))
+	for _, n := range r.notes() {
+		notef(n.key, n.value)
+	}
+	notef("want status", Unquoted(statusText(want)))
+	return ErrSilent
+}
+
+// HeaderMatches returns a Checker checking that the value of the given header
+// in an HTTP response satisfies the given checker. The response can be
+// provided as an *http.Response or as an *httptest.ResponseRecorder. Multiple
+// values for the header are joined with ", ", and the check fails if the
+// header is not present. The checker arguments, if any, must be provided after
+// the response.
+//
+// On failure, the request and the response are reported as with StatusEquals.
+//
+// For instance:
+//
+//	c.Assert(resp, qt.HeaderMatches("Content-Type", qt.Equals), "application/json")
+//	c.Assert(resp, qt.HeaderMatches("Cache-Control", qt.Contains), "no-store")
+func HeaderMatches(name string, checker Checker) Checker {
+	return &headerMatchesChecker{
+		argNames: append([]string{"response"}, checker.ArgNames()[1:]...),
+		name:     name,
+		checker:  checker,
+	}
+}
+
+type headerMatchesChecker struct {
+	argNames
+	name    string
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that the value of the c.name
+// header in the got response satisfies c.checker.
+func (c *headerMatchesChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	r, err := httpResponse(got, notef)
+	if err != nil {
+		return err
+	}
+	context := append([]note{{"header", c.name}}, r.notes()...)
+	values, ok := r.header[textproto.CanonicalMIMEHeaderKey(c.name)]
+	if !ok {
+		notef("error", Unquoted(fmt.Sprintf("header %q not found", c.name)))
+		for _, n := range context {
+			notef(n.key, n.value)
+		}
+		return ErrSilent
+	}
+	return checkNested(c.checker, strings.Join(values, ", "), args, "header value", notef, context...)
+}
+
+// BodyEquals is a Checker checking that the body of an HTTP response is equal
+// to the given string or byte slice. The response can be provided as an
+// *http.Response or as an *httptest.ResponseRecorder.
+//
+// On failure, the request and the response are reported as with
+// StatusEquals, with a line diff for multi-line text bodies.
+//
+// For instance:
+//
+//	c.Assert(rec, qt.BodyEquals, "hello world\n")
+var BodyEquals Checker = &bodyEqualsChecker{
+	argNames: []string{"response", "body"},
+}
+
+type bodyEqualsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the body of the got
+// response is equal to args[0].
+func (c *bodyEqualsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	var want []byte
+	switch v := args[0].(type) {
+	case string:
+		want = []byte(v)
+	case []byte:
+		want = v
+	default:
+		notef("body", args[0])
+		return BadCheckf("body is not a string or a []byte")
+	}
+	r, err := httpResponse(got, notef)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(r.body, want) {
+		return nil
+	}
+	notef("error", Unquoted("bodies are not equal"))
+	for _, n := range r.notes() {
+		notef(n.key, n.value)
+	}
+	notef("want body", reportedBody(want))
+	if utf8.Valid(r.body) && utf8.Valid(want) && (bytes.Contains(r.body, []byte("\n")) || bytes.Contains(want, []byte("\n"))) {
+		notef("line diff (-want +got)", Unquoted(lineDiff(string(r.body), string(want))))
+	}
+	return ErrSilent
+}
+
+// BodyJSONEquals is a Checker checking that the body of an HTTP response
+// contains JSON which is equivalent to the given value, as done by
+// JSONEquals. The response can be provided as an *http.Response or as an
+// *httptest.ResponseRecorder.
+//
+// On failure, the request and the response are reported as with StatusEquals.
+//
+// For instance:
+//
+//	c.Assert(resp, qt.BodyJSONEquals, map[string]interface{}{"id": 47.0})
+var BodyJSONEquals Checker = &bodyJSONEqualsChecker{
+	argNames: []string{"response", "want"},
+}
+
+type bodyJSONEqualsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the body of the got
+// response is JSON equivalent to args[0].
+func (c *bodyJSONEqualsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	r, err := httpResponse(got, notef)
+	if err != nil {
+		return err
+	}
+	return checkNested(JSONEquals, r.body, args, "", notef, r.notes()...)
+}
+
+// responseInfo holds the information about an HTTP response used by the HTTP
+// checkers.
+type responseInfo struct {
+	method string
+	url    string
+	status int
+	header http.Header
+	body   []byte
+}
+
+// httpResponse returns the information about the given *http.Response or
+// *httptest.ResponseRecorder. The response body is buffered and replaced, so
+// that it can be read again.
+func httpResponse(got interface{}, note func(key string, value interface{})) (*responseInfo, error) {
+	var resp *http.Response
+	switch got := got.(type) {
+	case *http.Response:
+		resp = got
+	case interface{ Result() *http.Response }:
+		// Avoid depending on net/http/httptest for its ResponseRecorder.
+		resp = got.Result()
+	}
+	if resp == nil {
+		note("response", got)
+		return nil, BadCheckf("first argument is not an *http.Response or an *httptest.ResponseRecorder")
+	}
+	r := &responseInfo{
+		status: resp.StatusCode,
+		header: resp.Header,
+	}
+	if req := resp.Request; req != nil && req.URL != nil {
+		r.method, r.url = req.Method, req.URL.String()
+	}
+	switch body := resp.Body.(type) {
+	case nil:
+	case *bufferedBody:
+		r.body = body.data
+	default:
+		data, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, BadCheckf("cannot read response body: %v", err)
+		}
+		r.body = data
+	}
+	if resp.Body != nil {
+		resp.Body = &bufferedBody{
+			Reader: bytes.NewReader(r.body),
+			data:   r.body,
+		}
+	}
+	return r, nil
+}
+
+// notes returns the notes describing the response in failure reports.
+func (r *responseInfo) notes() []note {
+	var notes []note
+	if r.url != "" {
+		notes = append(notes, note{"request", Unquoted(r.method + " " + r.url)})
+	}
+	notes = append(notes, note{"status", Unquoted(statusText(r.status))})
+	if len(r.header) != 0 {
+		keys := make([]string, 0, len(r.header))
+		for key := range r.header {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var lines []string
+		for _, key := range keys {
+			for _, value := range r.header[key] {
+				lines = append(lines, key+": "+value)
+			}
+		}
+		notes = append(notes, note{"headers", Unquoted(strings.Join(lines, "\n"))})
+	}
+	return append(notes, note{"body", reportedBody(r.body)})
+}
+
+// bufferedBody is a response body which has already been read, and which can
+// be read again by the HTTP checkers.
+type bufferedBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer.
+func (b *bufferedBody) Close() error {
+	return nil
+}
+
+// statusText returns the representation of the given status code used in
+// failure reports.
+func statusText(code int) string {
+	if text := http.StatusText(code); text != "" {
+		return fmt.Sprintf("%d %s", code, text)
+	}
+	return fmt.Sprint(code)
+}
+
+// reportedBody returns the representation of the given body used in failure
+// reports, truncated to maxReportedBodyLen bytes.
+func reportedBody(body []byte) interface{} {
+	if len(body) <= maxReportedBodyLen {
+		return string(body)
+	}
+	n := maxReportedBodyLen
+	for n > 0 && !utf8.RuneStart(body[n]) {
+		n--
+	}
+	return Unquoted(fmt.Sprintf("%q... (%d bytes total)", body[:n], len(body)))
+}
@@ -0,0 +1,255 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestStatusEquals(t *testing.T) {
+	rec := newRecorder(http.StatusNotFound, "not found\n")
+	tt := &testingT{}
+	ok := qt.New(tt).Check(rec, qt.StatusEquals, http.StatusNotFound)
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.StatusEquals, http.StatusOK)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  status codes are not equal
+status:
+  404 Not Found
+headers:
+  Content-Type: text/plain; charset=utf-8
+body:
+  "not found\n"
+want status:
+  200 OK
+`)
+}
+
+func TestStatusEqualsResponse(t *testing.T) {
+	c := qt.New(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Date", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.WriteHeader(http.StatusTeapot)
+		fmt.Fprint(w, "short and stout")
+	}))
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/teapot")
+	c.Assert(err, qt.IsNil)
+	defer resp.Body.Close()
+
+	tt := &testingT{}
+	ok := qt.New(tt).Check(resp, qt.StatusEquals, http.StatusOK)
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  status codes are not equal
+request:
+  GET %s/teapot
+status:
+  418 I'm a teapot
+headers:
+  Content-Length: 15
+  Content-Type: text/plain; charset=utf-8
+  Date: Mon, 01 Jan 2024 00:00:00 GMT
+body:
+  "short and stout"
+want status:
+  200 OK
+`, srv.URL))
+
+	// The body can still be read after the check.
+	data, err := ioutil.ReadAll(resp.Body)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, "short and stout")
+	c.Assert(resp, qt.BodyEquals, "short and stout")
+}
+
+func TestStatusEqualsBadCheck(t *testing.T) {
+	tt := &testingT{}
+	ok := qt.New(tt).Check(newRecorder(http.StatusOK, ""), qt.StatusEquals, "200")
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: status is not an int
+status:
+  "200"
+`)
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check("response", qt.StatusEquals, http.StatusOK)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: first argument is not an *http.Response or an *httptest.ResponseRecorder
+response:
+  "response"
+`)
+}
+
+func TestHeaderMatches(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rec.Header().Add("Cache-Control", "no-cache")
+	rec.Header().Add("Cache-Control", "no-store")
+	rec.WriteString("{}")
+	tt := &testingT{}
+	ok := qt.New(tt).Check(rec, qt.HeaderMatches("cache-control", qt.Equals), "no-cache, no-store")
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.HeaderMatches("Cache-Control", qt.Equals), "no-cache")
+	checkResult(t, ok, tt.errorString(), `
+error:
+  values are not equal
+header:
+  "Cache-Control"
+status:
+  200 OK
+headers:
+  Cache-Control: no-cache
+  Cache-Control: no-store
+  Content-Type: text/plain; charset=utf-8
+body:
+  "{}"
+header value:
+  "no-cache, no-store"
+want:
+  "no-cache"
+`)
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.HeaderMatches("Location", qt.Matches), "/.*")
+	checkResult(t, ok, tt.errorString(), `
+error:
+  header "Location" not found
+header:
+  "Location"
+status:
+  200 OK
+headers:
+  Cache-Control: no-cache
+  Cache-Control: no-store
+  Content-Type: text/plain; charset=utf-8
+body:
+  "{}"
+`)
+}
+
+func TestHeaderMatchesArgNames(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(qt.HeaderMatches("Location", qt.Equals).ArgNames(), qt.DeepEquals, []string{"response", "want"})
+	c.Assert(qt.HeaderMatches("Location", qt.IsNotZero).ArgNames(), qt.DeepEquals, []string{"response"})
+}
+
+func TestBodyEquals(t *testing.T) {
+	rec := newRecorder(http.StatusOK, "hello\nthere\n")
+	tt := &testingT{}
+	ok := qt.New(tt).Check(rec, qt.BodyEquals, []byte("hello\nthere\n"))
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.BodyEquals, "hello\nworld\n")
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  bodies are not equal
+status:
+  200 OK
+headers:
+  Content-Type: text/plain; charset=utf-8
+body:
+  "hello\nthere\n"
+want body:
+  "hello\nworld\n"
+line diff (-want +got):
+%s
+`, diff([]string{"hello\n", "there\n", ""}, []string{"hello\n", "world\n", ""})))
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.BodyEquals, 42)
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: body is not a string or a []byte
+body:
+  int(42)
+`)
+}
+
+func TestBodyEqualsTruncatedBody(t *testing.T) {
+	rec := newRecorder(http.StatusOK, strings.Repeat("a", 2000))
+	tt := &testingT{}
+	ok := qt.New(tt).Check(rec, qt.BodyEquals, "a")
+	checkResult(t, ok, tt.errorString(), fmt.Sprintf(`
+error:
+  bodies are not equal
+status:
+  200 OK
+headers:
+  Content-Type: text/plain; charset=utf-8
+body:
+  "%s"... (2000 bytes total)
+want body:
+  "a"
+`, strings.Repeat("a", 1024)))
+}
+
+func TestBodyEqualsReadError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(errReader{}),
+	}
+	tt := &testingT{}
+	ok := qt.New(tt).Check(resp, qt.BodyEquals, "")
+	checkResult(t, ok, tt.errorString(), `
+error:
+  bad check: cannot read response body: read failed
+`)
+}
+
+func TestBodyJSONEquals(t *testing.T) {
+	rec := newRecorder(http.StatusOK, `{"id": 47, "name": "bob"}`)
+	tt := &testingT{}
+	ok := qt.New(tt).Check(rec, qt.BodyJSONEquals, map[string]interface{}{"id": 47, "name": "bob"})
+	checkResult(t, ok, tt.errorString(), "")
+
+	tt = &testingT{}
+	ok = qt.New(tt).Check(rec, qt.BodyJSONEquals, map[string]interface{}{"id": 47, "name": "alice"})
+	assertBool(t, ok, false)
+	qt.Assert(t, tt.errorString(), qt.Matches, `(?s)
+error:
+  values are not deep equal
+diff \(-want \+got\):
+.*"alice".*
+want:
+.*
+status:
+  200 OK
+headers:
+  Content-Type: text/plain; charset=utf-8
+body:
+  .*`)
+}
+
+// newRecorder returns a response recorder holding a response with the given
+// status code and body.
+func newRecorder(status int, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rec.WriteHeader(status)
+	rec.WriteString(body)
+	return rec
+}
+
+// errReader is an io.Reader always returning an error.
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
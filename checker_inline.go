@@ -0,0 +1,219 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// InlineEquals is a Checker checking that the snapshot of the provided value,
+// as returned by Snapshot, is equal to the given string.
+//
+// When the tests are run with the -qt.update flag, or with the QT_UPDATE
+// environment variable set to "1", the string literal passed to the checker
+// is rewritten in the test source with the snapshot of the provided value,
+// instead of failing. For this to work, the snapshot must be provided as a
+// string literal directly in the call to Assert or Check, and the value must
+// be the same every time the call is executed.
+//
+// For instance:
+//
+//	c.Assert(strings.Fields(" a b "), qt.InlineEquals, `[]string{"a", "b"}`)
+var InlineEquals Checker = &inlineEqualsChecker{
+	argNames: []string{"got", "snapshot"},
+}
+
+type inlineEqualsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that the snapshot of got is
+// equal to args[0], or by rewriting args[0] in the test source in update
+// mode.
+func (c *inlineEqualsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	want, ok := args[0].(string)
+	if !ok {
+		note("snapshot", args[0])
+		return BadCheckf("snapshot is not a string")
+	}
+	snapshot := Snapshot(got)
+	if snapshot == want {
+		return nil
+	}
+	if updating() {
+		file, line, ok := callerLine()
+		if !ok {
+			return BadCheckf("cannot update inline snapshot: caller not found")
+		}
+		if err := rewriteInlineSnapshot(file, line, snapshot); err != nil {
+			return BadCheckf("cannot update inline snapshot: %v", err)
+		}
+		return nil
+	}
+	if strings.Contains(snapshot, "\n") || strings.Contains(want, "\n") {
+		note("line diff (-want +got)", Unquoted(lineDiff(snapshot, want)))
+	}
+	return errors.New("value does not match inline snapshot")
+}
+
+// Snapshot returns the snapshot of the given value used by InlineEquals:
+// strings are used as is, and other values are formatted as they are in
+// failure reports.
+func Snapshot(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return Format(v)
+}
+
+// callerLine returns the file and line of the call to the quicktest API from
+// outside this package.
+func callerLine() (string, int, bool) {
+	pc := make([]uintptr, 16)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	thisPackage := reflect.TypeOf(C{}).PkgPath() + "."
+	genericPackage := reflect.TypeOf(C{}).PkgPath() + "/qtgeneric."
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, thisPackage) && !strings.HasPrefix(frame.Function, genericPackage) {
+			return frame.File, frame.Line, true
+		}
+		if !more {
+			return "", 0, false
+		}
+	}
+}
+
+var (
+	// inlineMu protects inlineShifts and the rewriting of source files.
+	inlineMu sync.Mutex
+	// inlineShifts holds, for each rewritten source file, the changes in the
+	// number of lines made by rewriting snapshots. Line numbers reported by
+	// the runtime refer to the original sources, so they must be adjusted.
+	inlineShifts = make(map[string][]lineShift)
+)
+
+// lineShift records that the lines after the given original line moved by
+// delta lines.
+type lineShift struct {
+	line  int
+	delta int
+}
+
+// rewriteInlineSnapshot rewrites the snapshot literal provided to the
+// InlineEquals call found at the given original line of the given source
+// file.
+func rewriteInlineSnapshot(file string, line int, snapshot string) error {
+	inlineMu.Lock()
+	defer inlineMu.Unlock()
+	current := line
+	for _, s := range inlineShifts[file] {
+		if s.line < line {
+			current += s.delta
+		}
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, src, 0)
+	if err != nil {
+		return fmt.Errorf("cannot parse source file: %v", err)
+	}
+	lit := findSnapshotLiteral(fset, f, current)
+	if lit == nil {
+		return fmt.Errorf("no InlineEquals call with a string literal found at %s:%d", file, current)
+	}
+	newLit := snapshotLiteral(snapshot)
+	start, end := fset.Position(lit.Pos()).Offset, fset.Position(lit.End()).Offset
+	out := make([]byte, 0, len(src)-(end-start)+len(newLit))
+	out = append(out, src[:start]...)
+	out = append(out, newLit...)
+	out = append(out, src[end:]...)
+	if err := ioutil.WriteFile(file, out, info.Mode()); err != nil {
+		return err
+	}
+	if delta := strings.Count(newLit, "\n") - strings.Count(lit.Value, "\n"); delta != 0 {
+		inlineShifts[file] = append(inlineShifts[file], lineShift{
+			line:  line,
+			delta: delta,
+		})
+	}
+	return nil
+}
+
+// findSnapshotLiteral returns the string literal following InlineEquals in
+// the innermost call spanning the given line, or nil if there is none.
+func findSnapshotLiteral(fset *token.FileSet, f *ast.File, line int) *ast.BasicLit {
+	var lit *ast.BasicLit
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		if fset.Position(n.Pos()).Line > line || fset.Position(n.End()).Line < line {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		for i := 1; i < len(call.Args); i++ {
+			if !isInlineEquals(call.Args[i-1]) {
+				continue
+			}
+			if l, ok := call.Args[i].(*ast.BasicLit); ok && l.Kind == token.STRING {
+				// Keep looking for inner calls.
+				lit = l
+			}
+		}
+		return true
+	})
+	return lit
+}
+
+// isInlineEquals reports whether the given expression refers to InlineEquals.
+func isInlineEquals(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name == "InlineEquals"
+	case *ast.SelectorExpr:
+		return expr.Sel.Name == "InlineEquals"
+	}
+	return false
+}
+
+// snapshotLiteral returns the Go string literal for the given snapshot. A raw
+// string literal is used, when possible, for snapshots including newlines,
+// quotes or backslashes.
+func snapshotLiteral(snapshot string) string {
+	if !strings.ContainsAny(snapshot, "\n\"\\") || !utf8.ValidString(snapshot) {
+		return strconv.Quote(snapshot)
+	}
+	for _, r := range snapshot {
+		// Raw string literals cannot include back quotes, and carriage returns
+		// are discarded from them.
+		if r == '`' || r == '\r' || r != '\n' && r != '\t' && !unicode.IsPrint(r) {
+			return strconv.Quote(snapshot)
+		}
+	}
+	return "`" + snapshot + "`"
+}
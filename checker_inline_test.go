@@ -0,0 +1,176 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, inlineCheckerTests...)
+}
+
+var inlineCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "InlineEquals: same string",
+	checker: qt.InlineEquals,
+	got:     "hello",
+	args:    []interface{}{"hello"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "hello"
+snapshot:
+  <same as "got">
+`,
+}, {
+	about:   "InlineEquals: formatted value",
+	checker: qt.InlineEquals,
+	got:     []int{1, 2},
+	args:    []interface{}{"[]int{1, 2}"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []int{1, 2}
+snapshot:
+  "[]int{1, 2}"
+`,
+}, {
+	about:   "InlineEquals: different string",
+	checker: qt.InlineEquals,
+	got:     "hello",
+	args:    []interface{}{"world"},
+	expectedCheckFailure: `
+error:
+  value does not match inline snapshot
+got:
+  "hello"
+snapshot:
+  "world"
+`,
+}, {
+	about:   "InlineEquals: different multi-line string",
+	checker: qt.InlineEquals,
+	got:     "hello\nthere\n",
+	args:    []interface{}{"hello\nworld\n"},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  value does not match inline snapshot
+line diff (-want +got):
+%s
+got:
+  "hello\nthere\n"
+snapshot:
+  "hello\nworld\n"
+`, diff([]string{"hello\n", "there\n", ""}, []string{"hello\n", "world\n", ""})),
+}, {
+	about:   "InlineEquals: snapshot not a string",
+	checker: qt.InlineEquals,
+	got:     42,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: snapshot is not a string
+snapshot:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: snapshot is not a string
+snapshot:
+  int(42)
+`,
+}}
+
+func TestSnapshot(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(qt.Snapshot("hello\n"), qt.Equals, "hello\n")
+	c.Assert(qt.Snapshot(map[string]int{"a": 1}), qt.Equals, `map[string]int{"a":1}`)
+}
+
+func TestInlineEqualsUpdateNotLiteral(t *testing.T) {
+	c := qt.New(t)
+	c.Setenv("QT_UPDATE", "1")
+	want := "old"
+	tt := &testingT{}
+	ok := qt.New(tt).Check("new", qt.InlineEquals, want)
+	assertBool(t, ok, false)
+	c.Assert(tt.errorString(), qt.Matches, `(?s)
+error:
+  bad check: cannot update inline snapshot: no InlineEquals call with a string literal found at .*checker_inline_test.go:\d+
+.*`)
+}
+
+func TestRewriteInlineSnapshot(t *testing.T) {
+	c := qt.New(t)
+	path := filepath.Join(c.Mkdir(), "example_test.go")
+	err := ioutil.WriteFile(path, []byte(rewriteSource), 0644)
+	c.Assert(err, qt.IsNil)
+
+	// Rewrite the snapshots in order, using the original line numbers.
+	err = qt.RewriteInlineSnapshot(path, 10, "a\nb\n")
+	c.Assert(err, qt.IsNil)
+	err = qt.RewriteInlineSnapshot(path, 11, "with `backquote`\n")
+	c.Assert(err, qt.IsNil)
+	err = qt.RewriteInlineSnapshot(path, 13, "[]int{1, 2}")
+	c.Assert(err, qt.IsNil)
+	err = qt.RewriteInlineSnapshot(path, 16, `"last"`)
+	c.Assert(err, qt.IsNil)
+
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(data), qt.Equals, `package example
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestExample(t *testing.T) {
+	qt.Assert(t, "a\nb\n", qt.InlineEquals, `+"`a\nb\n`"+`)
+	qt.Assert(t, "with `+"`backquote`"+`\n", qt.InlineEquals, "with `+"`backquote`"+`\n")
+	qt.Assert(t, []int{1, 2},
+		qt.InlineEquals,
+		"[]int{1, 2}",
+	)
+	qt.Assert(t, "last", qt.InlineEquals, `+"`\"last\"`"+`)
+}
+`)
+
+	err = qt.RewriteInlineSnapshot(path, 4, "snapshot")
+	c.Assert(err, qt.ErrorMatches, `no InlineEquals call with a string literal found at .*example_test.go:4`)
+}
+
+const rewriteSource = `package example
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestExample(t *testing.T) {
+	qt.Assert(t, "a\nb\n", qt.InlineEquals, "")
+	qt.Assert(t, "with ` + "`backquote`" + `\n", qt.InlineEquals, "")
+	qt.Assert(t, []int{1, 2},
+		qt.InlineEquals,
+		"",
+	)
+	qt.Assert(t, "last", qt.InlineEquals, ` + "`old\nsnapshot`" + `)
+}
+`
@@ -0,0 +1,316 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONContains is a Checker checking that a byte slice or string contains
+// JSON which is a superset of the JSON encoding of the given Go value. JSON
+// objects are compared recursively, ignoring the keys which are not present
+// in the wanted value. Arrays must have the same length, and their elements
+// are compared recursively. Other values must be equal.
+//
+// On failure, each mismatch is reported with its JSON path.
+//
+// For instance:
+//
+//	c.Assert(body, qt.JSONContains, map[string]interface{}{
+//		"name":  "bob",
+//		"roles": []string{"admin"},
+//	})
+var JSONContains Checker = &jsonContainsChecker{
+	argNames: []string{"got", "want"},
+}
+
+type jsonContainsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that got contains the JSON
+// encoding of args[0].
+func (c *jsonContainsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	gotVal, err := jsonValue(got)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(args[0])
+	if err != nil {
+		return BadCheckf("cannot marshal expected contents: %v", err)
+	}
+	var wantVal interface{}
+	if err := json.Unmarshal(data, &wantVal); err != nil {
+		return BadCheckf("cannot unmarshal expected contents: %v", err)
+	}
+	var mismatches []jsonMismatch
+	jsonContains(gotVal, wantVal, "$", &mismatches)
+	for _, m := range mismatches {
+		note("error", Unquoted(m.reason))
+		note("path", Unquoted(m.path))
+		if m.reason != jsonKeyNotFound {
+			note("got value", jsonText(m.got))
+		}
+		note("want value", jsonText(m.want))
+	}
+	switch len(mismatches) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("JSON value at %s does not match", mismatches[0].path)
+	}
+	return fmt.Errorf("%d JSON values do not match", len(mismatches))
+}
+
+const jsonKeyNotFound = "key not found"
+
+// jsonMismatch holds a difference found by jsonContains.
+type jsonMismatch struct {
+	path      string
+	reason    string
+	got, want interface{}
+}
+
+// jsonContains appends to mismatches the differences preventing the decoded
+// JSON value got from containing want, which is found at the given path.
+func jsonContains(got, want interface{}, path string, mismatches *[]jsonMismatch) {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got, ok := got.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(want))
+		for k := range want {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := path + jsonPathKey(k)
+			v, ok := got[k]
+			if !ok {
+				*mismatches = append(*mismatches, jsonMismatch{
+					path:   p,
+					reason: jsonKeyNotFound,
+					want:   want[k],
+				})
+				continue
+			}
+			jsonContains(v, want[k], p, mismatches)
+		}
+		return
+	case []interface{}:
+		got, ok := got.([]interface{})
+		if !ok {
+			break
+		}
+		if len(got) != len(want) {
+			*mismatches = append(*mismatches, jsonMismatch{
+				path:   path,
+				reason: fmt.Sprintf("array has %d elements, want %d", len(got), len(want)),
+				got:    got,
+				want:   want,
+			})
+			return
+		}
+		for i := range want {
+			jsonContains(got[i], want[i], path+"["+strconv.Itoa(i)+"]", mismatches)
+		}
+		return
+	default:
+		if got == want {
+			return
+		}
+	}
+	*mismatches = append(*mismatches, jsonMismatch{
+		path:   path,
+		reason: "values are not equal",
+		got:    got,
+		want:   want,
+	})
+}
+
+// JSONPathMatches returns a Checker checking that the value found at the
+// given path in a byte slice or string containing JSON satisfies the given
+// checker. The checker arguments, if any, must be provided after the JSON.
+//
+// The path starts with "$", which refers to the whole document, followed by
+// object keys, like ".name" or `["first name"]`, and array indexes, like
+// "[0]". The value passed to the checker is decoded with encoding/json into
+// an interface{}, so JSON numbers are float64 values.
+//
+// For instance:
+//
+//	c.Assert(body, qt.JSONPathMatches("$.items[0].id", qt.Equals), 47.0)
+//	c.Assert(body, qt.JSONPathMatches("$.items", qt.HasLen), 3)
+func JSONPathMatches(path string, checker Checker) Checker {
+	return &jsonPathChecker{
+		argNames: append([]string{"got"}, checker.ArgNames()[1:]...),
+		path:     path,
+		checker:  checker,
+	}
+}
+
+type jsonPathChecker struct {
+	argNames
+	path    string
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that the value found at c.path
+// in got satisfies c.checker.
+func (c *jsonPathChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	elems, err := parseJSONPath(c.path)
+	if err != nil {
+		notef("path", c.path)
+		return BadCheckf("invalid JSON path: %s", err)
+	}
+	v, err := jsonValue(got)
+	if err != nil {
+		return err
+	}
+	path := "$"
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case string:
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				notef("value", jsonText(v))
+				return fmt.Errorf("JSON value at %s is not an object", path)
+			}
+			if v, ok = obj[elem]; !ok {
+				notef("present keys", sortedKeys(reflect.ValueOf(obj)))
+				return fmt.Errorf("key %q not found at %s", elem, path)
+			}
+			path += jsonPathKey(elem)
+		case int:
+			arr, ok := v.([]interface{})
+			if !ok {
+				notef("value", jsonText(v))
+				return fmt.Errorf("JSON value at %s is not an array", path)
+			}
+			if elem >= len(arr) {
+				notef("length", len(arr))
+				return fmt.Errorf("index %d out of range at %s", elem, path)
+			}
+			v = arr[elem]
+			path += "[" + strconv.Itoa(elem) + "]"
+		}
+	}
+	return checkNested(c.checker, v, args, "value", notef, note{"path", Unquoted(path)})
+}
+
+// parseJSONPath parses the given JSON path, returning its elements: strings
+// for object keys and ints for array indexes.
+func parseJSONPath(path string) ([]interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New(`path must start with "$"`)
+	}
+	var elems []interface{}
+	for rest := path[1:]; rest != ""; {
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			if end == 0 {
+				return nil, errors.New("empty key")
+			}
+			elems = append(elems, rest[1:end+1])
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, `["`):
+			end := closingQuote(rest[1:])
+			if end == -1 || !strings.HasPrefix(rest[end+2:], "]") {
+				return nil, fmt.Errorf("unterminated key in %q", rest)
+			}
+			key, err := strconv.Unquote(rest[1 : end+2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid key in %q: %v", rest, err)
+			}
+			elems = append(elems, key)
+			rest = rest[end+3:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated index in %q", rest)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q", rest[1:end])
+			}
+			elems = append(elems, index)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q", rest)
+		}
+	}
+	return elems, nil
+}
+
+// closingQuote returns the index of the quote closing the double quoted
+// string at the start of s, or -1 if the string is not terminated.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// jsonPathKey returns the JSON path element for the given object key.
+func jsonPathKey(key string) string {
+	if key == "" {
+		return `[""]`
+	}
+	for i, r := range key {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			return "[" + strconv.Quote(key) + "]"
+		}
+	}
+	return "." + key
+}
+
+// jsonValue decodes the JSON held by the given string or byte slice.
+func jsonValue(got interface{}) (interface{}, error) {
+	var data []byte
+	switch got := got.(type) {
+	case string:
+		data = []byte(got)
+	case []byte:
+		data = got
+	case json.RawMessage:
+		data = got
+	default:
+		return nil, BadCheckf("expected string or byte, got %T", got)
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal obtained contents: %v; %q", err, data)
+	}
+	return v, nil
+}
+
+// jsonText returns the compact JSON encoding of the given decoded value, for
+// reporting it.
+func jsonText(v interface{}) Unquoted {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		// Decoded values can always be encoded.
+		panic(err)
+	}
+	return Unquoted(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,466 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"encoding/json"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, jsonCheckerTests...)
+}
+
+const jsonDocument = `{
+	"name": "bob",
+	"age": 42,
+	"tags": ["a", "b"],
+	"items": [{"id": 1, "price": 10.5}, {"id": 2, "price": 3}],
+	"first name": "Robert",
+	"html": "<b>"
+}`
+
+var jsonCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "JSONContains: success",
+	checker: qt.JSONContains,
+	got:     jsonDocument,
+	args: []interface{}{map[string]interface{}{
+		"name":  "bob",
+		"items": []interface{}{map[string]int{"id": 1}, map[string]int{}},
+	}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  map[string]interface {}{
+      "items": []interface {}{
+          map[string]int{"id":1},
+          map[string]int{},
+      },
+      "name": "bob",
+  }
+`,
+}, {
+	about:   "JSONContains: struct",
+	checker: qt.JSONContains,
+	got:     []byte(jsonDocument),
+	args: []interface{}{struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}{"bob", 42}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []uint8("{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}")
+want:
+  struct { Name string "json:\"name\""; Age int "json:\"age\"" }{Name:"bob", Age:42}
+`,
+}, {
+	about:   "JSONContains: raw message",
+	checker: qt.JSONContains,
+	got:     json.RawMessage(`[1, {"a": true, "b": null}]`),
+	args:    []interface{}{[]interface{}{1, map[string]bool{"a": true}}},
+	expectedNegateFailure: tilde2bq(`
+error:
+  unexpected success
+got:
+  s~[1, {"a": true, "b": null}]~
+want:
+  []interface {}{
+      int(1),
+      map[string]bool{"a":true},
+  }
+`),
+}, {
+	about:   "JSONContains: value mismatch",
+	checker: qt.JSONContains,
+	got:     jsonDocument,
+	args: []interface{}{map[string]interface{}{
+		"items": []interface{}{map[string]int{"id": 1}, map[string]int{"id": 3}},
+	}},
+	expectedCheckFailure: `
+error:
+  JSON value at $.items[1].id does not match
+error:
+  values are not equal
+path:
+  $.items[1].id
+got value:
+  2
+want value:
+  3
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  map[string]interface {}{
+      "items": []interface {}{
+          map[string]int{"id":1},
+          map[string]int{"id":3},
+      },
+  }
+`,
+}, {
+	about:   "JSONContains: multiple mismatches",
+	checker: qt.JSONContains,
+	got:     jsonDocument,
+	args: []interface{}{map[string]interface{}{
+		"name":       "alice",
+		"tags":       []string{"a"},
+		"email":      "alice@example.com",
+		"first name": 42,
+		"html":       "<i>",
+	}},
+	expectedCheckFailure: `
+error:
+  5 JSON values do not match
+error:
+  key not found
+path:
+  $.email
+want value:
+  "alice@example.com"
+error:
+  values are not equal
+path:
+  $["first name"]
+got value:
+  "Robert"
+want value:
+  42
+error:
+  values are not equal
+path:
+  $.html
+got value:
+  "<b>"
+want value:
+  "<i>"
+error:
+  values are not equal
+path:
+  $.name
+got value:
+  "bob"
+want value:
+  "alice"
+error:
+  array has 2 elements, want 1
+path:
+  $.tags
+got value:
+  ["a","b"]
+want value:
+  ["a"]
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  map[string]interface {}{
+      "email":      "alice@example.com",
+      "first name": int(42),
+      "html":       "<i>",
+      "name":       "alice",
+      "tags":       []string{"a"},
+  }
+`,
+}, {
+	about:   "JSONContains: type mismatch",
+	checker: qt.JSONContains,
+	got:     `{"items": {"id": 1}}`,
+	args:    []interface{}{map[string]interface{}{"items": []int{1}}},
+	expectedCheckFailure: tilde2bq(`
+error:
+  JSON value at $.items does not match
+error:
+  values are not equal
+path:
+  $.items
+got value:
+  {"id":1}
+want value:
+  [1]
+got:
+  ~{"items": {"id": 1}}~
+want:
+  map[string]interface {}{
+      "items": []int{1},
+  }
+`),
+}, {
+	about:   "JSONContains: invalid JSON",
+	checker: qt.JSONContains,
+	got:     `{"name": `,
+	args:    []interface{}{map[string]interface{}{}},
+	expectedCheckFailure: tilde2bq(`
+error:
+  cannot unmarshal obtained contents: unexpected end of JSON input; "{\"name\": "
+got:
+  ~{"name": ~
+want:
+  map[string]interface {}{
+  }
+`),
+}, {
+	about:   "JSONContains: bad type",
+	checker: qt.JSONContains,
+	got:     42,
+	args:    []interface{}{map[string]interface{}{}},
+	expectedCheckFailure: `
+error:
+  bad check: expected string or byte, got int
+`,
+	expectedNegateFailure: `
+error:
+  bad check: expected string or byte, got int
+`,
+}, {
+	about:   "JSONContains: cannot marshal expected value",
+	checker: qt.JSONContains,
+	got:     `{}`,
+	args:    []interface{}{make(chan int)},
+	expectedCheckFailure: `
+error:
+  bad check: cannot marshal expected contents: json: unsupported type: chan int
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot marshal expected contents: json: unsupported type: chan int
+`,
+}, {
+	about:   "JSONPathMatches: success",
+	checker: qt.JSONPathMatches("$.items[1].price", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{3.0},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  float64(3)
+`,
+}, {
+	about:   "JSONPathMatches: quoted key",
+	checker: qt.JSONPathMatches(`$["first name"]`, qt.Matches),
+	got:     jsonDocument,
+	args:    []interface{}{"Rob.*"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+regexp:
+  "Rob.*"
+`,
+}, {
+	about:   "JSONPathMatches: whole document",
+	checker: qt.JSONPathMatches("$", qt.HasLen),
+	got:     `[1, 2, 3]`,
+	args:    []interface{}{3},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "[1, 2, 3]"
+want length:
+  int(3)
+`,
+}, {
+	about:   "JSONPathMatches: checker failure",
+	checker: qt.JSONPathMatches("$.items[0].id", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{47.0},
+	expectedCheckFailure: `
+error:
+  values are not equal
+path:
+  $.items[0].id
+value:
+  float64(1)
+want:
+  float64(47)
+`,
+}, {
+	about:   "JSONPathMatches: checker without arguments",
+	checker: qt.JSONPathMatches("$.tags", qt.IsNil),
+	got:     jsonDocument,
+	expectedCheckFailure: `
+error:
+  got non-nil value
+path:
+  $.tags
+value:
+  []interface {}{
+      "a",
+      "b",
+  }
+`,
+}, {
+	about:   "JSONPathMatches: key not found",
+	checker: qt.JSONPathMatches("$.items[0].name", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  key "name" not found at $.items[0]
+present keys:
+  []string{"id", "price"}
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  "bob"
+`,
+}, {
+	about:   "JSONPathMatches: index out of range",
+	checker: qt.JSONPathMatches("$.tags[2]", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"c"},
+	expectedCheckFailure: `
+error:
+  index 2 out of range at $.tags
+length:
+  int(2)
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  "c"
+`,
+}, {
+	about:   "JSONPathMatches: not an object",
+	checker: qt.JSONPathMatches("$.name.first", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  JSON value at $.name is not an object
+value:
+  "bob"
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  <same as "value">
+`,
+}, {
+	about:   "JSONPathMatches: not an array",
+	checker: qt.JSONPathMatches("$.name[0]", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  JSON value at $.name is not an array
+value:
+  "bob"
+got:
+  "{\n\t\"name\": \"bob\",\n\t\"age\": 42,\n\t\"tags\": [\"a\", \"b\"],\n\t\"items\": [{\"id\": 1, \"price\": 10.5}, {\"id\": 2, \"price\": 3}],\n\t\"first name\": \"Robert\",\n\t\"html\": \"<b>\"\n}"
+want:
+  <same as "value">
+`,
+}, {
+	about:   "JSONPathMatches: invalid path",
+	checker: qt.JSONPathMatches("items[0]", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  bad check: invalid JSON path: path must start with "$"
+path:
+  "items[0]"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: invalid JSON path: path must start with "$"
+path:
+  "items[0]"
+`,
+}, {
+	about:   "JSONPathMatches: invalid index",
+	checker: qt.JSONPathMatches("$.items[-1]", qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  bad check: invalid JSON path: invalid index "-1"
+path:
+  "$.items[-1]"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: invalid JSON path: invalid index "-1"
+path:
+  "$.items[-1]"
+`,
+}, {
+	about:   "JSONPathMatches: unterminated key",
+	checker: qt.JSONPathMatches(`$["items]`, qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: tilde2bq(`
+error:
+  bad check: invalid JSON path: unterminated key in "[\"items]"
+path:
+  ~$["items]~
+`),
+	expectedNegateFailure: tilde2bq(`
+error:
+  bad check: invalid JSON path: unterminated key in "[\"items]"
+path:
+  ~$["items]~
+`),
+}, {
+	about:   "JSONPathMatches: empty key",
+	checker: qt.JSONPathMatches(`$..items`, qt.Equals),
+	got:     jsonDocument,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  bad check: invalid JSON path: empty key
+path:
+  "$..items"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: invalid JSON path: empty key
+path:
+  "$..items"
+`,
+}, {
+	about:   "JSONPathMatches: invalid JSON",
+	checker: qt.JSONPathMatches("$.name", qt.Equals),
+	got:     `{`,
+	args:    []interface{}{"bob"},
+	expectedCheckFailure: `
+error:
+  cannot unmarshal obtained contents: unexpected end of JSON input; "{"
+got:
+  "{"
+want:
+  "bob"
+`,
+}, {
+	about:   "JSONPathMatches: not enough arguments",
+	checker: qt.JSONPathMatches("$.name", qt.Equals),
+	got:     jsonDocument,
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+}}
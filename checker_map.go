@@ -0,0 +1,179 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+)
+
+// HasKey is a Checker checking that the provided map has the given key.
+// On failure, the sorted list of the keys present in the map is reported.
+//
+// For instance:
+//
+//	c.Assert(headers, qt.HasKey, "Content-Type")
+var HasKey Checker = &hasKeyChecker{
+	argNames: []string{"got", "key"},
+}
+
+type hasKeyChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that got has the args[0] key.
+func (c *hasKeyChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	m, err := mapValue(got, note)
+	if err != nil {
+		return err
+	}
+	key, err := mapKey(m, args[0], note)
+	if err != nil {
+		return err
+	}
+	if m.MapIndex(key).IsValid() {
+		return nil
+	}
+	note("present keys", sortedKeys(m))
+	return errors.New("key not found in map")
+}
+
+// HasKeys returns a Checker checking that the provided map has all the given
+// keys. On failure, the missing keys and the sorted list of the keys present
+// in the map are reported.
+//
+// For instance:
+//
+//	c.Assert(config, qt.HasKeys("host", "port"))
+func HasKeys(keys ...interface{}) Checker {
+	return &hasKeysChecker{
+		argNames: []string{"got"},
+		keys:     keys,
+	}
+}
+
+type hasKeysChecker struct {
+	argNames
+	keys []interface{}
+}
+
+// Check implements Checker.Check by checking that got has all c.keys.
+func (c *hasKeysChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	m, err := mapValue(got, note)
+	if err != nil {
+		return err
+	}
+	missing := reflect.MakeSlice(reflect.SliceOf(m.Type().Key()), 0, len(c.keys))
+	for _, k := range c.keys {
+		key, err := mapKey(m, k, note)
+		if err != nil {
+			return err
+		}
+		if !m.MapIndex(key).IsValid() {
+			missing = reflect.Append(missing, key)
+		}
+	}
+	if missing.Len() == 0 {
+		return nil
+	}
+	note("missing keys", missing.Interface())
+	note("present keys", sortedKeys(m))
+	if missing.Len() == 1 {
+		return errors.New("key not found in map")
+	}
+	return errors.New("keys not found in map")
+}
+
+// MapContains returns a Checker checking that the provided map has the given
+// key, and that the value stored under that key satisfies the given checker.
+// The checker arguments, if any, must be provided after the map.
+//
+// For instance:
+//
+//	c.Assert(counts, qt.MapContains("errors", qt.Equals), 0)
+//	c.Assert(env, qt.MapContains("PATH", qt.Matches), ".*/usr/bin.*")
+func MapContains(key interface{}, checker Checker) Checker {
+	return &mapContainsChecker{
+		argNames: append([]string{"got"}, checker.ArgNames()[1:]...),
+		key:      key,
+		checker:  checker,
+	}
+}
+
+type mapContainsChecker struct {
+	argNames
+	key     interface{}
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that got has the c.key key and
+// that its value satisfies c.checker.
+func (c *mapContainsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	m, err := mapValue(got, notef)
+	if err != nil {
+		return err
+	}
+	key, err := mapKey(m, c.key, notef)
+	if err != nil {
+		return err
+	}
+	value := m.MapIndex(key)
+	if !value.IsValid() {
+		notef("key", c.key)
+		notef("present keys", sortedKeys(m))
+		return errors.New("key not found in map")
+	}
+	return checkNested(c.checker, value.Interface(), args, "value", notef, note{"key", c.key})
+}
+
+// mapValue returns the reflect value for the given map, or a BadCheck error
+// if got is not a map.
+func mapValue(got interface{}, note func(key string, value interface{})) (reflect.Value, error) {
+	v := reflect.ValueOf(got)
+	if v.Kind() != reflect.Map {
+		note("got", got)
+		return reflect.Value{}, BadCheckf("first argument is not a map")
+	}
+	return v, nil
+}
+
+// mapKey returns the given key as a reflect value that can be used to index
+// the given map, or a BadCheck error if the key has the wrong type.
+func mapKey(m reflect.Value, key interface{}, note func(key string, value interface{})) (reflect.Value, error) {
+	keyType := m.Type().Key()
+	v := reflect.ValueOf(key)
+	if !v.IsValid() {
+		switch keyType.Kind() {
+		case reflect.Chan, reflect.Interface, reflect.Ptr:
+			return reflect.Zero(keyType), nil
+		}
+		note("key", key)
+		return reflect.Value{}, BadCheckf("cannot use nil as key of type %s", keyType)
+	}
+	if !v.Type().AssignableTo(keyType) {
+		note("key", key)
+		return reflect.Value{}, BadCheckf("key of type %T cannot be used as key of type %s", key, keyType)
+	}
+	return v, nil
+}
+
+// sortedKeys returns a slice with the sorted keys of the given map.
+func sortedKeys(m reflect.Value) interface{} {
+	keys := m.MapKeys()
+	sortValues(keys)
+	s := reflect.MakeSlice(reflect.SliceOf(m.Type().Key()), 0, len(keys))
+	return reflect.Append(s, keys...).Interface()
+}
+
+// sortValues sorts the given values. Values that cannot be ordered are sorted
+// by their formatted representation.
+func sortValues(vs []reflect.Value) {
+	sort.SliceStable(vs, func(i, j int) bool {
+		x, y := vs[i].Interface(), vs[j].Interface()
+		if c, err := compareOrdered(x, y); err == nil {
+			return c < 0
+		}
+		return Format(x) < Format(y)
+	})
+}
@@ -0,0 +1,326 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"fmt"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, mapCheckerTests...)
+}
+
+var mapCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "HasKey: success",
+	checker: qt.HasKey,
+	got:     map[string]int{"a": 1, "b": 2},
+	args:    []interface{}{"b"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  map[string]int{"a":1, "b":2}
+key:
+  "b"
+`,
+}, {
+	about:   "HasKey: failure",
+	checker: qt.HasKey,
+	got:     map[string]int{"c": 1, "a": 2, "b": 3},
+	args:    []interface{}{"d"},
+	expectedCheckFailure: `
+error:
+  key not found in map
+present keys:
+  []string{"a", "b", "c"}
+got:
+  map[string]int{"a":2, "b":3, "c":1}
+key:
+  "d"
+`,
+}, {
+	about:   "HasKey: nil key in interface map",
+	checker: qt.HasKey,
+	got:     map[interface{}]bool{nil: true},
+	args:    []interface{}{nil},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  map[interface {}]bool{nil:true}
+key:
+  nil
+`,
+}, {
+	about:   "HasKey: unordered keys",
+	checker: qt.HasKey,
+	got:     map[[2]int]bool{{2, 1}: true, {1, 2}: true},
+	args:    []interface{}{[2]int{}},
+	expectedCheckFailure: `
+error:
+  key not found in map
+present keys:
+  [][2]int{
+      {1, 2},
+      {2, 1},
+  }
+got:
+  map[[2]int]bool{{1, 2}:true, {2, 1}:true}
+key:
+  [2]int{0, 0}
+`,
+}, {
+	about:   "HasKey: nil key",
+	checker: qt.HasKey,
+	got:     map[string]int{},
+	args:    []interface{}{nil},
+	expectedCheckFailure: `
+error:
+  bad check: cannot use nil as key of type string
+key:
+  nil
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot use nil as key of type string
+key:
+  nil
+`,
+}, {
+	about:   "HasKey: wrong key type",
+	checker: qt.HasKey,
+	got:     map[int64]bool{42: true},
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: key of type int cannot be used as key of type int64
+key:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: key of type int cannot be used as key of type int64
+key:
+  int(42)
+`,
+}, {
+	about:   "HasKey: not a map",
+	checker: qt.HasKey,
+	got:     []string{"a"},
+	args:    []interface{}{0},
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a map
+got:
+  []string{"a"}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a map
+got:
+  []string{"a"}
+`,
+}, {
+	about:   "HasKeys: success",
+	checker: qt.HasKeys("a", "b"),
+	got:     map[string]int{"a": 1, "b": 2, "c": 3},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  map[string]int{"a":1, "b":2, "c":3}
+`,
+}, {
+	about:   "HasKeys: no keys",
+	checker: qt.HasKeys(),
+	got:     map[string]int{},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  map[string]int{}
+`,
+}, {
+	about:   "HasKeys: one key missing",
+	checker: qt.HasKeys("a", "d"),
+	got:     map[string]int{"c": 3, "b": 2, "a": 1},
+	expectedCheckFailure: `
+error:
+  key not found in map
+missing keys:
+  []string{"d"}
+present keys:
+  []string{"a", "b", "c"}
+got:
+  map[string]int{"a":1, "b":2, "c":3}
+`,
+}, {
+	about:   "HasKeys: keys missing",
+	checker: qt.HasKeys(42, 47, 1),
+	got:     map[int]bool{1: true, 3: false},
+	expectedCheckFailure: `
+error:
+  keys not found in map
+missing keys:
+  []int{42, 47}
+present keys:
+  []int{1, 3}
+got:
+  map[int]bool{1:true, 3:false}
+`,
+}, {
+	about:   "HasKeys: wrong key type",
+	checker: qt.HasKeys("a", 1),
+	got:     map[string]int{"a": 1},
+	expectedCheckFailure: `
+error:
+  bad check: key of type int cannot be used as key of type string
+key:
+  int(1)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: key of type int cannot be used as key of type string
+key:
+  int(1)
+`,
+}, {
+	about:   "MapContains: success",
+	checker: qt.MapContains("a", qt.Equals),
+	got:     map[string]int{"a": 1, "b": 2},
+	args:    []interface{}{1},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  map[string]int{"a":1, "b":2}
+want:
+  int(1)
+`,
+}, {
+	about:   "MapContains: missing key",
+	checker: qt.MapContains("c", qt.Equals),
+	got:     map[string]int{"a": 1, "b": 2},
+	args:    []interface{}{1},
+	expectedCheckFailure: `
+error:
+  key not found in map
+key:
+  "c"
+present keys:
+  []string{"a", "b"}
+got:
+  map[string]int{"a":1, "b":2}
+want:
+  int(1)
+`,
+}, {
+	about:   "MapContains: value mismatch",
+	checker: qt.MapContains("b", qt.Equals),
+	got:     map[string]int{"a": 1, "b": 2},
+	args:    []interface{}{1},
+	expectedCheckFailure: `
+error:
+  values are not equal
+key:
+  "b"
+value:
+  int(2)
+want:
+  int(1)
+`,
+}, {
+	about:   "MapContains: nested checker without arguments",
+	checker: qt.MapContains("b", qt.IsNil),
+	got:     map[string]error{"a": nil, "b": errBadWolf},
+	expectedCheckFailure: `
+error:
+  got non-nil error
+key:
+  "b"
+value:
+  bad wolf
+    file:line
+`,
+}, {
+	about:   "MapContains: silent nested failure",
+	checker: qt.MapContains("a", qt.DeepEquals),
+	got:     map[string][]int{"a": {1}},
+	args:    []interface{}{[]int{2}},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  []int{1}
+want:
+  []int{2}
+key:
+  "a"
+`, diff([]int{1}, []int{2})),
+}, {
+	about:   "MapContains: nested bad check",
+	checker: qt.MapContains("a", qt.Matches),
+	got:     map[string]int{"a": 1},
+	args:    []interface{}{"1"},
+	expectedCheckFailure: `
+error:
+  bad check: value is not a string or a fmt.Stringer
+value:
+  int(1)
+key:
+  "a"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: value is not a string or a fmt.Stringer
+value:
+  int(1)
+key:
+  "a"
+`,
+}, {
+	about:   "MapContains: wrong key type",
+	checker: qt.MapContains(1, qt.Equals),
+	got:     map[string]int{"a": 1},
+	args:    []interface{}{1},
+	expectedCheckFailure: `
+error:
+  bad check: key of type int cannot be used as key of type string
+key:
+  int(1)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: key of type int cannot be used as key of type string
+key:
+  int(1)
+`,
+}, {
+	about:   "MapContains: not enough arguments",
+	checker: qt.MapContains("a", qt.Equals),
+	got:     map[string]int{"a": 1},
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+}}
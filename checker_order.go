@@ -0,0 +1,240 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// GreaterThan is a Checker checking that the provided value is greater than
+// the given bound. Values with ordered underlying kinds (integers, floating
+// point numbers and strings) and time.Time values are supported. Both values
+// must have the same kind.
+//
+// For instance:
+//
+//	c.Assert(len(results), qt.GreaterThan, 5)
+//	c.Assert(elapsed, qt.GreaterThan, time.Second)
+//	c.Assert(deadline, qt.GreaterThan, time.Now())
+var GreaterThan Checker = &orderChecker{
+	argNames: []string{"got", "bound"},
+	ok:       func(c int) bool { return c > 0 },
+	msg:      "value is not greater than bound",
+}
+
+// AtLeast is a Checker checking that the provided value is greater than or
+// equal to the given bound. See GreaterThan for the supported values.
+//
+// For instance:
+//
+//	c.Assert(retries, qt.AtLeast, 3)
+var AtLeast Checker = &orderChecker{
+	argNames: []string{"got", "bound"},
+	ok:       func(c int) bool { return c >= 0 },
+	msg:      "value is less than bound",
+}
+
+// LessThan is a Checker checking that the provided value is less than the
+// given bound. See GreaterThan for the supported values.
+//
+// For instance:
+//
+//	c.Assert(elapsed, qt.LessThan, 100*time.Millisecond)
+var LessThan Checker = &orderChecker{
+	argNames: []string{"got", "bound"},
+	ok:       func(c int) bool { return c < 0 },
+	msg:      "value is not less than bound",
+}
+
+// AtMost is a Checker checking that the provided value is less than or equal
+// to the given bound. See GreaterThan for the supported values.
+//
+// For instance:
+//
+//	c.Assert(name, qt.AtMost, "m")
+var AtMost Checker = &orderChecker{
+	argNames: []string{"got", "bound"},
+	ok:       func(c int) bool { return c <= 0 },
+	msg:      "value is greater than bound",
+}
+
+type orderChecker struct {
+	argNames
+	// ok reports whether the result of comparing got and the bound is
+	// acceptable.
+	ok  func(c int) bool
+	msg string
+}
+
+// Check implements Checker.Check by checking that the result of comparing got
+// with args[0] is acceptable.
+func (c *orderChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	bound := args[0]
+	cmp, err := compareOrdered(got, bound)
+	if err != nil {
+		return err
+	}
+	if c.ok(cmp) {
+		return nil
+	}
+	if diff := difference(got, bound); diff != nil {
+		note("got - bound", diff)
+	}
+	return errors.New(c.msg)
+}
+
+// Between is a Checker checking that the provided value is between the given
+// min and max values, inclusive. See GreaterThan for the supported values.
+//
+// For instance:
+//
+//	c.Assert(percent, qt.Between, 0, 100)
+//	c.Assert(elapsed, qt.Between, time.Second, 2*time.Second)
+var Between Checker = &betweenChecker{
+	argNames: []string{"got", "min", "max"},
+}
+
+type betweenChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that args[0] <= got <= args[1].
+func (c *betweenChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	min, max := args[0], args[1]
+	cmp, err := compareOrdered(min, max)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		note("min", min)
+		note("max", max)
+		return BadCheckf("min is greater than max")
+	}
+	if cmp, err = compareOrdered(got, min); err != nil {
+		return err
+	}
+	if cmp < 0 {
+		if diff := difference(got, min); diff != nil {
+			note("got - min", diff)
+		}
+		return errors.New("value is less than min")
+	}
+	if cmp, err = compareOrdered(got, max); err != nil {
+		return err
+	}
+	if cmp > 0 {
+		if diff := difference(got, max); diff != nil {
+			note("got - max", diff)
+		}
+		return errors.New("value is greater than max")
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// compareOrdered compares the given values, returning -1, 0 or +1 depending
+// on whether x is less than, equal to or greater than y. A BadCheck error is
+// returned if the values cannot be compared. An error is also returned when
+// any of the values is a floating point NaN.
+func compareOrdered(x, y interface{}) (int, error) {
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if !xv.IsValid() || !yv.IsValid() {
+		return 0, BadCheckf("cannot compare %T with %T", x, y)
+	}
+	if xv.Type() == timeType || yv.Type() == timeType {
+		if xv.Type() != yv.Type() {
+			return 0, BadCheckf("cannot compare %T with %T", x, y)
+		}
+		xt, yt := x.(time.Time), y.(time.Time)
+		switch {
+		case xt.Before(yt):
+			return -1, nil
+		case xt.After(yt):
+			return 1, nil
+		}
+		return 0, nil
+	}
+	if xv.Kind() != yv.Kind() {
+		return 0, BadCheckf("cannot compare %T with %T: values must have the same kind", x, y)
+	}
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		a, b := xv.Int(), yv.Int()
+		switch {
+		case a < b:
+			return -1, nil
+		case a > b:
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		a, b := xv.Uint(), yv.Uint()
+		switch {
+		case a < b:
+			return -1, nil
+		case a > b:
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.Float32, reflect.Float64:
+		a, b := xv.Float(), yv.Float()
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return 0, errors.New("NaN values cannot be ordered")
+		}
+		switch {
+		case a < b:
+			return -1, nil
+		case a > b:
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.String:
+		a, b := xv.String(), yv.String()
+		switch {
+		case a < b:
+			return -1, nil
+		case a > b:
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, BadCheckf("cannot compare values of type %T: type is not ordered", x)
+}
+
+// difference returns x-y for the given comparable values, or nil if the
+// difference is not meaningful, as for strings. When it does not overflow, the
+// result has the same type as x, so that for instance the difference between
+// two time.Duration values is a time.Duration.
+func difference(x, y interface{}) interface{} {
+	if xt, ok := x.(time.Time); ok {
+		return xt.Sub(y.(time.Time))
+	}
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	v := reflect.New(xv.Type()).Elem()
+	d := new(big.Int)
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.Sub(big.NewInt(xv.Int()), big.NewInt(yv.Int()))
+		if d.IsInt64() && !v.OverflowInt(d.Int64()) {
+			v.SetInt(d.Int64())
+			return v.Interface()
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d.Sub(new(big.Int).SetUint64(xv.Uint()), new(big.Int).SetUint64(yv.Uint()))
+		if d.IsUint64() && !v.OverflowUint(d.Uint64()) {
+			v.SetUint(d.Uint64())
+			return v.Interface()
+		}
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(xv.Float() - yv.Float())
+		return v.Interface()
+	default:
+		return nil
+	}
+	return Unquoted(d.String())
+}
@@ -0,0 +1,404 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"math"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, orderCheckerTests...)
+}
+
+type myInt int
+
+var orderCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "GreaterThan: greater ints",
+	checker: qt.GreaterThan,
+	got:     47,
+	args:    []interface{}{42},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(47)
+bound:
+  int(42)
+`,
+}, {
+	about:   "GreaterThan: equal ints",
+	checker: qt.GreaterThan,
+	got:     42,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  int(0)
+got:
+  int(42)
+bound:
+  <same as "got">
+`,
+}, {
+	about:   "GreaterThan: smaller named ints",
+	checker: qt.GreaterThan,
+	got:     myInt(42),
+	args:    []interface{}{47},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  quicktest_test.myInt(-5)
+got:
+  quicktest_test.myInt(42)
+bound:
+  int(47)
+`,
+}, {
+	about:   "GreaterThan: int8 overflow",
+	checker: qt.GreaterThan,
+	got:     int8(-100),
+	args:    []interface{}{int8(100)},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  -200
+got:
+  int8(-100)
+bound:
+  int8(100)
+`,
+}, {
+	about:   "GreaterThan: uints",
+	checker: qt.GreaterThan,
+	got:     uint(1),
+	args:    []interface{}{uint(3)},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  -2
+got:
+  uint(1)
+bound:
+  uint(3)
+`,
+}, {
+	about:   "GreaterThan: floats",
+	checker: qt.GreaterThan,
+	got:     float32(1.5),
+	args:    []interface{}{float32(2)},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  float32(-0.5)
+got:
+  float32(1.5)
+bound:
+  float32(2)
+`,
+}, {
+	about:   "GreaterThan: NaN",
+	checker: qt.GreaterThan,
+	got:     math.NaN(),
+	args:    []interface{}{1.0},
+	expectedCheckFailure: `
+error:
+  NaN values cannot be ordered
+got:
+  float64(NaN)
+bound:
+  float64(1)
+`,
+}, {
+	about:   "GreaterThan: strings",
+	checker: qt.GreaterThan,
+	got:     "a",
+	args:    []interface{}{"b"},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got:
+  "a"
+bound:
+  "b"
+`,
+}, {
+	about:   "GreaterThan: durations",
+	checker: qt.GreaterThan,
+	got:     time.Second,
+	args:    []interface{}{2 * time.Second},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  s"-1s"
+got:
+  s"1s"
+bound:
+  s"2s"
+`,
+}, {
+	about:   "GreaterThan: times",
+	checker: qt.GreaterThan,
+	got:     goTime,
+	args:    []interface{}{goTime.Add(time.Hour)},
+	expectedCheckFailure: `
+error:
+  value is not greater than bound
+got - bound:
+  s"-1h0m0s"
+got:
+  s"2012-03-28 00:00:00 +0000 UTC"
+bound:
+  s"2012-03-28 01:00:00 +0000 UTC"
+`,
+}, {
+	about:   "GreaterThan: mixed kinds",
+	checker: qt.GreaterThan,
+	got:     int64(42),
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare int64 with int: values must have the same kind
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare int64 with int: values must have the same kind
+`,
+}, {
+	about:   "GreaterThan: time and other type",
+	checker: qt.GreaterThan,
+	got:     goTime,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare time.Time with int
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare time.Time with int
+`,
+}, {
+	about:   "GreaterThan: nil value",
+	checker: qt.GreaterThan,
+	got:     nil,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare <nil> with int
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare <nil> with int
+`,
+}, {
+	about:   "GreaterThan: not ordered",
+	checker: qt.GreaterThan,
+	got:     true,
+	args:    []interface{}{false},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare values of type bool: type is not ordered
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare values of type bool: type is not ordered
+`,
+}, {
+	about:   "AtLeast: equal values",
+	checker: qt.AtLeast,
+	got:     42,
+	args:    []interface{}{42},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(42)
+bound:
+  <same as "got">
+`,
+}, {
+	about:   "AtLeast: smaller value",
+	checker: qt.AtLeast,
+	got:     41,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  value is less than bound
+got - bound:
+  int(-1)
+got:
+  int(41)
+bound:
+  int(42)
+`,
+}, {
+	about:   "LessThan: smaller value",
+	checker: qt.LessThan,
+	got:     41,
+	args:    []interface{}{42},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(41)
+bound:
+  int(42)
+`,
+}, {
+	about:   "LessThan: greater value",
+	checker: qt.LessThan,
+	got:     100 * time.Millisecond,
+	args:    []interface{}{10 * time.Millisecond},
+	expectedCheckFailure: `
+error:
+  value is not less than bound
+got - bound:
+  s"90ms"
+got:
+  s"100ms"
+bound:
+  s"10ms"
+`,
+}, {
+	about:   "AtMost: equal values",
+	checker: qt.AtMost,
+	got:     "m",
+	args:    []interface{}{"m"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "m"
+bound:
+  <same as "got">
+`,
+}, {
+	about:   "AtMost: greater value",
+	checker: qt.AtMost,
+	got:     uint64(math.MaxUint64),
+	args:    []interface{}{uint64(0)},
+	expectedCheckFailure: `
+error:
+  value is greater than bound
+got - bound:
+  uint64(18446744073709551615)
+got:
+  <same as "got - bound">
+bound:
+  uint64(0)
+`,
+}, {
+	about:   "Between: value in range",
+	checker: qt.Between,
+	got:     42,
+	args:    []interface{}{0, 100},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(42)
+min:
+  int(0)
+max:
+  int(100)
+`,
+}, {
+	about:   "Between: value at bounds",
+	checker: qt.Between,
+	got:     100,
+	args:    []interface{}{0, 100},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(100)
+min:
+  int(0)
+max:
+  <same as "got">
+`,
+}, {
+	about:   "Between: value below min",
+	checker: qt.Between,
+	got:     -1,
+	args:    []interface{}{0, 100},
+	expectedCheckFailure: `
+error:
+  value is less than min
+got - min:
+  int(-1)
+got:
+  <same as "got - min">
+min:
+  int(0)
+max:
+  int(100)
+`,
+}, {
+	about:   "Between: value above max",
+	checker: qt.Between,
+	got:     3 * time.Second,
+	args:    []interface{}{time.Second, 2 * time.Second},
+	expectedCheckFailure: `
+error:
+  value is greater than max
+got - max:
+  s"1s"
+got:
+  s"3s"
+min:
+  <same as "got - max">
+max:
+  s"2s"
+`,
+}, {
+	about:   "Between: min greater than max",
+	checker: qt.Between,
+	got:     42,
+	args:    []interface{}{100, 0},
+	expectedCheckFailure: `
+error:
+  bad check: min is greater than max
+min:
+  int(100)
+max:
+  int(0)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: min is greater than max
+min:
+  int(100)
+max:
+  int(0)
+`,
+}, {
+	about:   "Between: mixed kinds",
+	checker: qt.Between,
+	got:     42.0,
+	args:    []interface{}{0, 100},
+	expectedCheckFailure: `
+error:
+  bad check: cannot compare float64 with int: values must have the same kind
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot compare float64 with int: values must have the same kind
+`,
+}}
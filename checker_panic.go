@@ -0,0 +1,132 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"reflect"
+	"runtime/debug"
+	"strings"
+)
+
+// Panics returns a Checker checking that the provided function panics, and
+// that the recovered value satisfies the given checker. Unlike PanicMatches,
+// the checker receives the raw recovered value. The checker arguments, if
+// any, must be provided after the function.
+//
+// For instance:
+//
+//	c.Assert(func() { panic(ErrInvalid) }, qt.Panics(qt.ErrorIs), ErrInvalid)
+//	c.Assert(func() { mustParse("") }, qt.Panics(qt.DeepEquals), &ParseError{Line: 1})
+func Panics(checker Checker) Checker {
+	return &panicsChecker{
+		argNames: append([]string{"function"}, checker.ArgNames()[1:]...),
+		checker:  checker,
+	}
+}
+
+type panicsChecker struct {
+	argNames
+	checker Checker
+}
+
+// Check implements Checker.Check by checking that got is a func() that panics
+// with a value satisfying c.checker.
+func (c *panicsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	f, err := funcValue(got, note)
+	if err != nil {
+		return err
+	}
+	r, _, panicked := callFunc(f)
+	if !panicked {
+		return errors.New("function did not panic")
+	}
+	return checkNested(c.checker, r, args, "panic value", note)
+}
+
+// DoesNotPanic is a Checker checking that the provided function does not
+// panic. On failure, the panic value and the stack of the panicking goroutine
+// are reported.
+//
+// For instance:
+//
+//	c.Assert(func() { handler.ServeHTTP(w, req) }, qt.DoesNotPanic)
+var DoesNotPanic Checker = &doesNotPanicChecker{
+	argNames: []string{"function"},
+}
+
+type doesNotPanicChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that got is a func() that does
+// not panic.
+func (c *doesNotPanicChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	f, err := funcValue(got, note)
+	if err != nil {
+		return err
+	}
+	r, stack, panicked := callFunc(f)
+	if !panicked {
+		return nil
+	}
+	note("panic value", r)
+	note("panic stack", Unquoted(panicStack(stack)))
+	return errors.New("function panicked")
+}
+
+// funcValue returns the reflect value for the given function, or a BadCheck
+// error if got is not a function without arguments.
+func funcValue(got interface{}, note func(key string, value interface{})) (reflect.Value, error) {
+	f := reflect.ValueOf(got)
+	if f.Kind() != reflect.Func {
+		note("got", got)
+		return reflect.Value{}, BadCheckf("first argument is not a function")
+	}
+	if f.Type().NumIn() != 0 {
+		note("function", got)
+		return reflect.Value{}, BadCheckf("cannot use a function receiving arguments")
+	}
+	return f, nil
+}
+
+// callFunc calls the given function, and returns the recovered value and the
+// stack of the goroutine if the function panics. The panicked result is true
+// even if the function panics with a nil value.
+func callFunc(f reflect.Value) (r interface{}, stack []byte, panicked bool) {
+	panicked = true
+	defer func() {
+		if panicked {
+			r = recover()
+			// Deferred functions run before the stack is unwound, so the
+			// stack still includes the panicking function.
+			stack = debug.Stack()
+		}
+	}()
+	f.Call(nil)
+	panicked = false
+	return r, nil, false
+}
+
+// panicStack returns the given goroutine stack, captured while panicking,
+// without the frames above the panic call and the frames from the call to the
+// checked function down, so that only the panicking code is included.
+func panicStack(stack []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+	header, lines := lines[:1], lines[1:]
+	for i, line := range lines {
+		// Each frame is made of a function line and a file line.
+		if strings.HasPrefix(line, "panic(") && i+2 <= len(lines) {
+			lines = lines[i+2:]
+			break
+		}
+	}
+	for i, line := range lines {
+		// The checked function is called by callFunc using reflection.
+		if strings.HasPrefix(line, "reflect.Value.call(") {
+			lines = lines[:i]
+			break
+		}
+	}
+	return strings.Join(append(header, lines...), "\n")
+}
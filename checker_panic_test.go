@@ -0,0 +1,268 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, panicCheckerTests...)
+}
+
+type panicValue struct {
+	Code int
+	Msg  string
+}
+
+var errPanic = errors.New("bad wolf")
+
+var panicCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "Panics: error value",
+	checker: qt.Panics(qt.ErrorIs),
+	got: func() {
+		panic(fmt.Errorf("wrapped: %w", errPanic))
+	},
+	args: []interface{}{errPanic},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() {...}
+want:
+  e"bad wolf"
+`,
+}, {
+	about:   "Panics: struct value",
+	checker: qt.Panics(qt.DeepEquals),
+	got: func() {
+		panic(panicValue{Code: 42, Msg: "bad wolf"})
+	},
+	args: []interface{}{panicValue{Code: 42, Msg: "bad wolf"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() {...}
+want:
+  quicktest_test.panicValue{Code:42, Msg:"bad wolf"}
+`,
+}, {
+	about:   "Panics: checker without arguments",
+	checker: qt.Panics(qt.IsNotNil),
+	got: func() {
+		panic("bad wolf")
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() {...}
+`,
+}, {
+	about:   "Panics: function returning values",
+	checker: qt.Panics(qt.Equals),
+	got: func() int {
+		panic(47)
+	},
+	args: []interface{}{47},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() int {...}
+want:
+  int(47)
+`,
+}, {
+	about:   "Panics: checker failure",
+	checker: qt.Panics(qt.DeepEquals),
+	got: func() {
+		panic(panicValue{Code: 42, Msg: "bad wolf"})
+	},
+	args: []interface{}{panicValue{Code: 47, Msg: "bad wolf"}},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  quicktest_test.panicValue{Code:42, Msg:"bad wolf"}
+want:
+  quicktest_test.panicValue{Code:47, Msg:"bad wolf"}
+`, diff(panicValue{Code: 42, Msg: "bad wolf"}, panicValue{Code: 47, Msg: "bad wolf"})),
+}, {
+	about:   "Panics: error checker failure",
+	checker: qt.Panics(qt.ErrorIs),
+	got: func() {
+		panic(errors.New("other"))
+	},
+	args: []interface{}{errPanic},
+	expectedCheckFailure: `
+error:
+  wanted error is not found in error chain
+panic value:
+  e"other"
+want:
+  e"bad wolf"
+`,
+}, {
+	about:   "Panics: no panic",
+	checker: qt.Panics(qt.Equals),
+	got:     func() {},
+	args:    []interface{}{47},
+	expectedCheckFailure: `
+error:
+  function did not panic
+function:
+  func() {...}
+want:
+  int(47)
+`,
+}, {
+	about:   "Panics: nested bad check",
+	checker: qt.Panics(qt.HasLen),
+	got: func() {
+		panic(47)
+	},
+	args: []interface{}{1},
+	expectedCheckFailure: `
+error:
+  bad check: first argument has no length
+got:
+  int(47)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument has no length
+got:
+  int(47)
+`,
+}, {
+	about:   "Panics: not a function",
+	checker: qt.Panics(qt.IsNotNil),
+	got:     "not a function",
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  "not a function"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  "not a function"
+`,
+}, {
+	about:   "Panics: function receiving arguments",
+	checker: qt.Panics(qt.IsNotNil),
+	got:     func(int) {},
+	expectedCheckFailure: `
+error:
+  bad check: cannot use a function receiving arguments
+function:
+  func(int) {...}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot use a function receiving arguments
+function:
+  func(int) {...}
+`,
+}, {
+	about:   "Panics: not enough arguments",
+	checker: qt.Panics(qt.Equals),
+	got:     func() {},
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments provided to checker: got 0, want 1
+want args:
+  want
+`,
+}, {
+	about:   "DoesNotPanic: success",
+	checker: qt.DoesNotPanic,
+	got:     func() {},
+	expectedNegateFailure: `
+error:
+  unexpected success
+function:
+  func() {...}
+`,
+}, {
+	about:   "DoesNotPanic: not a function",
+	checker: qt.DoesNotPanic,
+	got:     42,
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a function
+got:
+  int(42)
+`,
+}}
+
+func TestDoesNotPanicFailure(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	ok := c.Check(func() {
+		panicHelper(panicValue{Code: 42, Msg: "bad wolf"})
+	}, qt.DoesNotPanic)
+	assertBool(t, ok, false)
+	qt.Assert(t, tt.errorString(), qt.Matches, `
+error:
+  function panicked
+panic value:
+  quicktest_test.panicValue\{Code:42, Msg:"bad wolf"\}
+panic stack:
+  goroutine \d+ \[running\]:
+  github.com/frankban/quicktest_test.panicHelper\(.*
+  \t.*/checker_panic_test.go:\d+.*
+  github.com/frankban/quicktest_test.TestDoesNotPanicFailure.func1\(\)
+  \t.*/checker_panic_test.go:\d+.*
+function:
+(.|\n)*`)
+	// The stack does not include the quicktest frames calling the function.
+	qt.Assert(t, tt.errorString(), qt.Not(qt.Contains), "reflect.Value.Call")
+	qt.Assert(t, tt.errorString(), qt.Not(qt.Contains), "quicktest.callFunc")
+}
+
+func TestPanicsNilValue(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	// The recovered value depends on the Go version: it is either nil or a
+	// *runtime.PanicNilError.
+	ok := c.Check(func() {
+		panic(nil)
+	}, qt.Panics(qt.Satisfies), func(interface{}) bool { return true })
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+}
+
+func panicHelper(v interface{}) {
+	panic(v)
+}
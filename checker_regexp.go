@@ -0,0 +1,170 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// MatchesGroups returns a Checker checking that the provided string, error or
+// fmt.Stringer matches the given regular expression pattern, and that the
+// named capture groups satisfy the corresponding checkers. The pattern can be
+// a string, in which case it is anchored, or a *regexp.Regexp, which is used
+// as is. Checkers must not require arguments: use Bind to provide them.
+//
+// On failure, all the captured groups are reported, as well as all the groups
+// which do not satisfy their checkers.
+//
+// For instance:
+//
+//	c.Assert(err, qt.MatchesGroups(`user (?P<id>\d+) not found`, map[string]qt.Checker{
+//		"id": qt.Bind(qt.Equals, "42"),
+//	}))
+func MatchesGroups(pattern interface{}, groups map[string]Checker) Checker {
+	return &matchesGroupsChecker{
+		argNames: []string{"got value"},
+		pattern:  pattern,
+		groups:   groups,
+	}
+}
+
+type matchesGroupsChecker struct {
+	argNames
+	pattern interface{}
+	groups  map[string]Checker
+}
+
+// Check implements Checker.Check by checking that got matches c.pattern and
+// that the captured groups satisfy c.groups.
+func (c *matchesGroupsChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	s, msg, err := matchedString(got, "match", notef)
+	if err != nil {
+		return err
+	}
+	var re *regexp.Regexp
+	switch p := c.pattern.(type) {
+	case *regexp.Regexp:
+		re = p
+	case string:
+		if re, err = regexp.Compile("^(?:" + p + ")$"); err != nil {
+			notef("regexp", p)
+			return BadCheckf("cannot compile regexp: %s", err)
+		}
+	default:
+		notef("regexp", c.pattern)
+		return BadCheckf("regexp is not a string")
+	}
+	names := make([]string, 0, len(c.groups))
+	for name := range c.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	indexes := make(map[string]int, len(names))
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			indexes[name] = i
+		}
+	}
+	for _, name := range names {
+		if _, ok := indexes[name]; !ok {
+			notef("regexp", c.pattern)
+			return BadCheckf("regexp has no group named %q", name)
+		}
+		if len(c.groups[name].ArgNames()) != 1 {
+			return BadCheckf("checker for group %q requires arguments: use Bind to provide them", name)
+		}
+	}
+
+	m := re.FindStringSubmatch(s)
+	if m == nil {
+		notef("regexp", c.pattern)
+		return errors.New(msg)
+	}
+	for i, name := range re.SubexpNames()[1:] {
+		if name == "" {
+			name = strconv.Itoa(i + 1)
+		}
+		notef("group "+name, m[i+1])
+	}
+	var failed []string
+	for _, name := range names {
+		// The group value is not repeated, as all groups are already reported.
+		err := checkNested(c.groups[name], m[indexes[name]], nil, "", notef, note{"group", Unquoted(name)})
+		if IsBadCheck(err) {
+			return err
+		}
+		if err != nil {
+			failed = append(failed, name)
+		}
+	}
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("group %q does not satisfy checker", failed[0])
+	}
+	return fmt.Errorf("%d groups do not satisfy their checkers", len(failed))
+}
+
+// ContainsMatch is a Checker checking that the provided string, error or
+// fmt.Stringer contains a match for the provided regular expression pattern.
+// Unlike Matches, the pattern is not anchored. On success, the leftmost match
+// is reported when the checker is negated.
+//
+// For instance:
+//
+//	c.Assert(output, qt.ContainsMatch, `request id: \w+`)
+var ContainsMatch Checker = &containsMatchChecker{
+	argNames: []string{"got value", "regexp"},
+}
+
+type containsMatchChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that got contains a match for
+// args[0].
+func (c *containsMatchChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	s, msg, err := matchedString(got, "contain a match for", note)
+	if err != nil {
+		return err
+	}
+	re, ok := args[0].(*regexp.Regexp)
+	if !ok {
+		pattern, ok := args[0].(string)
+		if !ok {
+			note("regexp", args[0])
+			return BadCheckf("regexp is not a string")
+		}
+		if re, err = regexp.Compile(pattern); err != nil {
+			note("regexp", pattern)
+			return BadCheckf("cannot compile regexp: %s", err)
+		}
+	}
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return errors.New(msg)
+	}
+	note("match", s[loc[0]:loc[1]])
+	return nil
+}
+
+// matchedString returns the string to match for the given string, error or
+// fmt.Stringer, and the failure message to use if the match fails, which is
+// built from the given verb.
+func matchedString(got interface{}, verb string, note func(key string, value interface{})) (string, string, error) {
+	switch v := got.(type) {
+	case string:
+		return v, "value does not " + verb + " regexp", nil
+	case error:
+		return v.Error(), "error does not " + verb + " regexp", nil
+	case fmt.Stringer:
+		return v.String(), "value.String() does not " + verb + " regexp", nil
+	}
+	note("value", got)
+	return "", "", BadCheckf("value is not a string, an error or a fmt.Stringer")
+}
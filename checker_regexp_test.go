@@ -0,0 +1,339 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, regexpCheckerTests...)
+}
+
+var regexpCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about: "MatchesGroups: success",
+	checker: qt.MatchesGroups(`user (?P<id>\d+) not found in (?P<db>\w+)`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+		"db": qt.Bind(qt.Matches, "users.*"),
+	}),
+	got: errors.New("user 42 not found in users_v2"),
+	expectedNegateFailure: `
+error:
+  unexpected success
+group id:
+  "42"
+group db:
+  "users_v2"
+got value:
+  e"user 42 not found in users_v2"
+`,
+}, {
+	about: "MatchesGroups: group failure",
+	checker: qt.MatchesGroups(`user (?P<id>\d+) not found in (\w+)`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "47"),
+	}),
+	got: "user 42 not found in users",
+	expectedCheckFailure: `
+error:
+  group "id" does not satisfy checker
+group id:
+  "42"
+group 2:
+  "users"
+error:
+  values are not equal
+group:
+  id
+want:
+  "47"
+got value:
+  "user 42 not found in users"
+`,
+}, {
+	about: "MatchesGroups: multiple group failures",
+	checker: qt.MatchesGroups(`(?P<key>\w+)=(?P<value>\w+)`, map[string]qt.Checker{
+		"key":   qt.Bind(qt.Equals, "name"),
+		"value": qt.Bind(qt.DeepEquals, "bad wolf"),
+	}),
+	got: "answer=42",
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  2 groups do not satisfy their checkers
+group key:
+  "answer"
+group value:
+  "42"
+error:
+  values are not equal
+group:
+  key
+want:
+  "name"
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  "42"
+want:
+  "bad wolf"
+group:
+  value
+got value:
+  "answer=42"
+`, diff("42", "bad wolf")),
+}, {
+	about: "MatchesGroups: checker without arguments",
+	checker: qt.MatchesGroups(`(?P<empty>x*)y`, map[string]qt.Checker{
+		"empty": qt.Bind(qt.Satisfies, func(s string) bool { return s == "" }),
+	}),
+	got: "xxy",
+	expectedCheckFailure: `
+error:
+  group "empty" does not satisfy checker
+group empty:
+  "xx"
+error:
+  value does not satisfy predicate function
+group:
+  empty
+predicate function:
+  func(string) bool {...}
+got value:
+  "xxy"
+`,
+}, {
+	about: "MatchesGroups: no match",
+	checker: qt.MatchesGroups(`user (?P<id>\d+)`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+	}),
+	got: "user 42 not found",
+	expectedCheckFailure: `
+error:
+  value does not match regexp
+regexp:
+  "user (?P<id>\\d+)"
+got value:
+  "user 42 not found"
+`,
+}, {
+	about: "MatchesGroups: compiled regexp",
+	checker: qt.MatchesGroups(regexp.MustCompile(`id=(?P<id>\d+)`), map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+	}),
+	got: "request id=42 failed",
+	expectedNegateFailure: `
+error:
+  unexpected success
+group id:
+  "42"
+got value:
+  "request id=42 failed"
+`,
+}, {
+	about: "MatchesGroups: stringer",
+	checker: qt.MatchesGroups(`(?P<n>\d+)(?P<unit>\w+)`, map[string]qt.Checker{
+		"n":    qt.Bind(qt.Equals, "42"),
+		"unit": qt.Bind(qt.Equals, "s"),
+	}),
+	got: 42 * time.Millisecond,
+	expectedCheckFailure: `
+error:
+  group "unit" does not satisfy checker
+group n:
+  "42"
+group unit:
+  "ms"
+error:
+  values are not equal
+group:
+  unit
+want:
+  "s"
+got value:
+  s"42ms"
+`,
+}, {
+	about: "MatchesGroups: unknown group",
+	checker: qt.MatchesGroups(`(?P<id>\d+)`, map[string]qt.Checker{
+		"name": qt.Bind(qt.Equals, "42"),
+	}),
+	got: "42",
+	expectedCheckFailure: `
+error:
+  bad check: regexp has no group named "name"
+regexp:
+  "(?P<id>\\d+)"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: regexp has no group named "name"
+regexp:
+  "(?P<id>\\d+)"
+`,
+}, {
+	about: "MatchesGroups: checker requiring arguments",
+	checker: qt.MatchesGroups(`(?P<id>\d+)`, map[string]qt.Checker{
+		"id": qt.Equals,
+	}),
+	got: "42",
+	expectedCheckFailure: `
+error:
+  bad check: checker for group "id" requires arguments: use Bind to provide them
+`,
+	expectedNegateFailure: `
+error:
+  bad check: checker for group "id" requires arguments: use Bind to provide them
+`,
+}, {
+	about: "MatchesGroups: invalid regexp",
+	checker: qt.MatchesGroups(`(?P<id>\d+`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+	}),
+	got: "42",
+	expectedCheckFailure: tilde2bq(`
+error:
+  bad check: cannot compile regexp: error parsing regexp: missing closing ): ~^(?:(?P<id>\d+)$~
+regexp:
+  "(?P<id>\\d+"
+`),
+	expectedNegateFailure: tilde2bq(`
+error:
+  bad check: cannot compile regexp: error parsing regexp: missing closing ): ~^(?:(?P<id>\d+)$~
+regexp:
+  "(?P<id>\\d+"
+`),
+}, {
+	about: "MatchesGroups: bad nested check",
+	checker: qt.MatchesGroups(`(?P<id>\d+)`, map[string]qt.Checker{
+		"id": qt.Bind(qt.ErrorMatches, "42"),
+	}),
+	got: "42",
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not an error
+group id:
+  "42"
+got:
+  <same as "group id">
+group:
+  id
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not an error
+group id:
+  "42"
+got:
+  <same as "group id">
+group:
+  id
+`,
+}, {
+	about: "MatchesGroups: not a string",
+	checker: qt.MatchesGroups(`(?P<id>\d+)`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+	}),
+	got: 42,
+	expectedCheckFailure: `
+error:
+  bad check: value is not a string, an error or a fmt.Stringer
+value:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: value is not a string, an error or a fmt.Stringer
+value:
+  int(42)
+`,
+}, {
+	about:   "ContainsMatch: success",
+	checker: qt.ContainsMatch,
+	got:     "request id: abc42 completed",
+	args:    []interface{}{`id: \w+`},
+	expectedNegateFailure: `
+error:
+  unexpected success
+match:
+  "id: abc42"
+got value:
+  "request id: abc42 completed"
+regexp:
+  "id: \\w+"
+`,
+}, {
+	about:   "ContainsMatch: failure",
+	checker: qt.ContainsMatch,
+	got:     "request completed",
+	args:    []interface{}{`id: \w+`},
+	expectedCheckFailure: `
+error:
+  value does not contain a match for regexp
+got value:
+  "request completed"
+regexp:
+  "id: \\w+"
+`,
+}, {
+	about:   "ContainsMatch: error",
+	checker: qt.ContainsMatch,
+	got:     errBadWolf,
+	args:    []interface{}{regexp.MustCompile(`^wolf`)},
+	expectedCheckFailure: `
+error:
+  error does not contain a match for regexp
+got value:
+  bad wolf
+    file:line
+regexp:
+  s"^wolf"
+`,
+}, {
+	about:   "ContainsMatch: invalid regexp",
+	checker: qt.ContainsMatch,
+	got:     "bad wolf",
+	args:    []interface{}{`(`},
+	expectedCheckFailure: tilde2bq(`
+error:
+  bad check: cannot compile regexp: error parsing regexp: missing closing ): ~(~
+regexp:
+  "("
+`),
+	expectedNegateFailure: tilde2bq(`
+error:
+  bad check: cannot compile regexp: error parsing regexp: missing closing ): ~(~
+regexp:
+  "("
+`),
+}, {
+	about:   "ContainsMatch: regexp not a string",
+	checker: qt.ContainsMatch,
+	got:     "bad wolf",
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: regexp is not a string
+regexp:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: regexp is not a string
+regexp:
+  int(42)
+`,
+}}
@@ -0,0 +1,313 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"reflect"
+)
+
+// IsSubsetOf is a Checker checking that all the elements of the provided
+// container are also elements of the given container. Containers can be
+// slices, arrays or maps, in which case their values are considered.
+// Elements are compared using DeepEquals. On failure, the elements that are
+// not in the given container are reported as unexpected.
+//
+// For instance:
+//
+//	c.Assert([]string{"a", "b"}, qt.IsSubsetOf, []string{"c", "b", "a"})
+//
+// See also IsSubsetOfWith.
+var IsSubsetOf = IsSubsetOfWith(DeepEquals)
+
+// IsSubsetOfWith is like IsSubsetOf, except that elements are compared using
+// the given checker, which must accept a single argument.
+//
+// For instance:
+//
+//	c.Assert([]float64{1.01}, qt.IsSubsetOfWith(qt.ApproxEquals(0.1)), []float64{1, 2})
+func IsSubsetOfWith(checker Checker) Checker {
+	return &setChecker{
+		argNames:    []string{"got", "want"},
+		elemChecker: checker,
+		relation:    subset,
+	}
+}
+
+// ContainsAll is a Checker checking that all the elements of the given
+// container are also elements of the provided container. See IsSubsetOf for
+// the supported containers. On failure, the elements that are not in the
+// provided container are reported as missing.
+//
+// For instance:
+//
+//	c.Assert(permissions, qt.ContainsAll, []string{"read", "write"})
+//
+// See also ContainsAllWith.
+var ContainsAll = ContainsAllWith(DeepEquals)
+
+// ContainsAllWith is like ContainsAll, except that elements are compared
+// using the given checker, which must accept a single argument.
+func ContainsAllWith(checker Checker) Checker {
+	return &setChecker{
+		argNames:    []string{"got", "want"},
+		elemChecker: checker,
+		relation:    superset,
+	}
+}
+
+// ContainsExactly is a Checker checking that the provided and the given
+// containers have the same elements, regardless of their order. Repeated
+// elements must appear the same number of times in both containers. See
+// IsSubsetOf for the supported containers. On failure, the missing and
+// unexpected elements are reported.
+//
+// For instance:
+//
+//	c.Assert(ids, qt.ContainsExactly, []int{3, 1, 2, 1})
+//
+// See also ContainsExactlyWith.
+var ContainsExactly = ContainsExactlyWith(DeepEquals)
+
+// ContainsExactlyWith is like ContainsExactly, except that elements are
+// compared using the given checker, which must accept a single argument.
+func ContainsExactlyWith(checker Checker) Checker {
+	return &setChecker{
+		argNames:    []string{"got", "want"},
+		elemChecker: checker,
+		relation:    exact,
+	}
+}
+
+// IsDisjoint is a Checker checking that the provided and the given containers
+// have no elements in common. See IsSubsetOf for the supported containers. On
+// failure, the common elements are reported.
+//
+// For instance:
+//
+//	c.Assert(allowed, qt.IsDisjoint, denied)
+//
+// See also IsDisjointWith.
+var IsDisjoint = IsDisjointWith(DeepEquals)
+
+// IsDisjointWith is like IsDisjoint, except that elements are compared using
+// the given checker, which must accept a single argument.
+func IsDisjointWith(checker Checker) Checker {
+	return &setChecker{
+		argNames:    []string{"got", "want"},
+		elemChecker: checker,
+		relation:    disjoint,
+	}
+}
+
+// setRelation holds a relationship between two containers.
+type setRelation int
+
+const (
+	subset setRelation = iota
+	superset
+	exact
+	disjoint
+)
+
+type setChecker struct {
+	argNames
+	elemChecker Checker
+	relation    setRelation
+}
+
+// Check implements Checker.Check by checking that the elements of got and
+// args[0] satisfy c.relation.
+func (c *setChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	if n := len(c.elemChecker.ArgNames()); n != 2 {
+		return BadCheckf("element checker must accept a single argument, not %d", n-1)
+	}
+	want := args[0]
+	gotElems, err := containerElems(got)
+	if err != nil {
+		return BadCheckf("got: %v", err)
+	}
+	wantElems, err := containerElems(want)
+	if err != nil {
+		return BadCheckf("want: %v", err)
+	}
+
+	missing := makeElemSlice(want)
+	unexpected := makeElemSlice(got)
+	common := makeElemSlice(got)
+	switch c.relation {
+	case subset, disjoint:
+		for _, g := range gotElems {
+			i, err := c.find(g, wantElems, note)
+			if err != nil {
+				return err
+			}
+			switch {
+			case i == -1 && c.relation == subset:
+				unexpected = reflect.Append(unexpected, g)
+			case i != -1 && c.relation == disjoint:
+				common = reflect.Append(common, g)
+			}
+		}
+	case superset:
+		for _, w := range wantElems {
+			found := false
+			for _, g := range gotElems {
+				ok, err := c.equal(g, w, note)
+				if err != nil {
+					return err
+				}
+				if ok {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = reflect.Append(missing, w)
+			}
+		}
+	case exact:
+		gotMatches, err := c.match(gotElems, wantElems, note)
+		if err != nil {
+			return err
+		}
+		matched := make([]bool, len(wantElems))
+		for i, g := range gotElems {
+			if gotMatches[i] == -1 {
+				unexpected = reflect.Append(unexpected, g)
+				continue
+			}
+			matched[gotMatches[i]] = true
+		}
+		for i, w := range wantElems {
+			if !matched[i] {
+				missing = reflect.Append(missing, w)
+			}
+		}
+	}
+
+	if missing.Len()+unexpected.Len()+common.Len() == 0 {
+		return nil
+	}
+	if missing.Len() != 0 {
+		note("missing", missing.Interface())
+	}
+	if unexpected.Len() != 0 {
+		note("unexpected", unexpected.Interface())
+	}
+	if common.Len() != 0 {
+		note("common", common.Interface())
+	}
+	switch c.relation {
+	case subset:
+		return errors.New("container has elements not in want")
+	case superset:
+		return errors.New("container does not contain all the elements")
+	case exact:
+		return errors.New("containers do not have the same elements")
+	}
+	return errors.New("containers have elements in common")
+}
+
+// find returns the index of the first element in elems which is equal to g,
+// or -1 if no element is equal.
+func (c *setChecker) find(g reflect.Value, elems []reflect.Value, note func(key string, value interface{})) (int, error) {
+	for i, w := range elems {
+		ok, err := c.equal(g, w, note)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// match pairs each got element with a distinct wanted element equal to it,
+// maximizing the number of pairs. Since the element checker is not necessarily
+// transitive, a maximum bipartite matching is computed using augmenting paths.
+// The index of the wanted element paired with each got element is returned,
+// or -1 if the got element is not paired.
+func (c *setChecker) match(gotElems, wantElems []reflect.Value, note func(key string, value interface{})) ([]int, error) {
+	equal := make([][]bool, len(gotElems))
+	for i, g := range gotElems {
+		equal[i] = make([]bool, len(wantElems))
+		for j, w := range wantElems {
+			ok, err := c.equal(g, w, note)
+			if err != nil {
+				return nil, err
+			}
+			equal[i][j] = ok
+		}
+	}
+	gotMatches := make([]int, len(gotElems))
+	for i := range gotMatches {
+		gotMatches[i] = -1
+	}
+	wantMatches := make([]int, len(wantElems))
+	for j := range wantMatches {
+		wantMatches[j] = -1
+	}
+	// augment tries to pair the got element at index i, possibly moving the
+	// got elements already paired to other wanted elements.
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j, ok := range equal[i] {
+			if !ok || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if wantMatches[j] == -1 || augment(wantMatches[j], visited) {
+				gotMatches[i], wantMatches[j] = j, i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range gotElems {
+		augment(i, make([]bool, len(wantElems)))
+	}
+	return gotMatches, nil
+}
+
+// equal reports whether the given elements are equal according to
+// c.elemChecker. The elements are reported if the check cannot be performed.
+func (c *setChecker) equal(g, w reflect.Value, note func(key string, value interface{})) (bool, error) {
+	err := c.elemChecker.Check(g.Interface(), []interface{}{w.Interface()}, func(key string, value interface{}) {})
+	if IsBadCheck(err) {
+		note("got element", g.Interface())
+		note("want element", w.Interface())
+		return false, err
+	}
+	return err == nil, nil
+}
+
+// containerElems returns the elements of the given slice or array, or the
+// values of the given map sorted by key.
+func containerElems(x interface{}) ([]reflect.Value, error) {
+	if v := reflect.ValueOf(x); v.Kind() == reflect.Map {
+		keys := v.MapKeys()
+		sortValues(keys)
+		elems := make([]reflect.Value, len(keys))
+		for i, k := range keys {
+			elems[i] = v.MapIndex(k)
+		}
+		return elems, nil
+	}
+	iter, err := newIter(x)
+	if err != nil {
+		return nil, err
+	}
+	var elems []reflect.Value
+	for iter.next() {
+		elems = append(elems, iter.value())
+	}
+	return elems, nil
+}
+
+// makeElemSlice returns an empty slice that can hold the elements of the given
+// container.
+func makeElemSlice(x interface{}) reflect.Value {
+	return reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(x).Elem()), 0, 0)
+}
@@ -0,0 +1,383 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, setCheckerTests...)
+}
+
+var setCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "IsSubsetOf: success",
+	checker: qt.IsSubsetOf,
+	got:     []string{"a", "b", "a"},
+	args:    []interface{}{[]string{"c", "b", "a"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []string{"a", "b", "a"}
+want:
+  []string{"c", "b", "a"}
+`,
+}, {
+	about:   "IsSubsetOf: empty set",
+	checker: qt.IsSubsetOf,
+	got:     []int(nil),
+	args:    []interface{}{[]int{}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []int(nil)
+want:
+  []int{}
+`,
+}, {
+	about:   "IsSubsetOf: failure",
+	checker: qt.IsSubsetOf,
+	got:     []string{"a", "d", "b", "e"},
+	args:    []interface{}{[]string{"c", "b", "a"}},
+	expectedCheckFailure: `
+error:
+  container has elements not in want
+unexpected:
+  []string{"d", "e"}
+got:
+  []string{"a", "d", "b", "e"}
+want:
+  []string{"c", "b", "a"}
+`,
+}, {
+	about:   "IsSubsetOf: map values",
+	checker: qt.IsSubsetOf,
+	got:     map[string]int{"b": 2, "a": 1, "c": 3},
+	args:    []interface{}{[...]int{1, 2}},
+	expectedCheckFailure: `
+error:
+  container has elements not in want
+unexpected:
+  []int{3}
+got:
+  map[string]int{"a":1, "b":2, "c":3}
+want:
+  [2]int{1, 2}
+`,
+}, {
+	about:   "IsSubsetOf: deep equality",
+	checker: qt.IsSubsetOf,
+	got:     [][]int{{1, 2}, {3}},
+	args:    []interface{}{[][]int{{3}, {1, 2}}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  [][]int{
+      {1, 2},
+      {3},
+  }
+want:
+  [][]int{
+      {3},
+      {1, 2},
+  }
+`,
+}, {
+	about:   "IsSubsetOf: not a container",
+	checker: qt.IsSubsetOf,
+	got:     42,
+	args:    []interface{}{[]int{42}},
+	expectedCheckFailure: `
+error:
+  bad check: got: map, slice or array required
+`,
+	expectedNegateFailure: `
+error:
+  bad check: got: map, slice or array required
+`,
+}, {
+	about:   "IsSubsetOf: want not a container",
+	checker: qt.IsSubsetOf,
+	got:     []int{42},
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: want: map, slice or array required
+`,
+	expectedNegateFailure: `
+error:
+  bad check: want: map, slice or array required
+`,
+}, {
+	about:   "IsSubsetOfWith: success",
+	checker: qt.IsSubsetOfWith(qt.ApproxEquals(0.1)),
+	got:     []float64{1.01, 1.95},
+	args:    []interface{}{[]float64{2, 1}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []float64{1.01, 1.95}
+want:
+  []float64{2, 1}
+`,
+}, {
+	about:   "IsSubsetOfWith: failure",
+	checker: qt.IsSubsetOfWith(qt.ApproxEquals(0.1)),
+	got:     []float64{1.01, 2.5},
+	args:    []interface{}{[]float64{2, 1}},
+	expectedCheckFailure: `
+error:
+  container has elements not in want
+unexpected:
+  []float64{2.5}
+got:
+  []float64{1.01, 2.5}
+want:
+  []float64{2, 1}
+`,
+}, {
+	about:   "IsSubsetOfWith: element bad check",
+	checker: qt.IsSubsetOfWith(qt.Matches),
+	got:     []int{1},
+	args:    []interface{}{[]string{"1"}},
+	expectedCheckFailure: `
+error:
+  bad check: value is not a string or a fmt.Stringer
+got element:
+  int(1)
+want element:
+  "1"
+`,
+	expectedNegateFailure: `
+error:
+  bad check: value is not a string or a fmt.Stringer
+got element:
+  int(1)
+want element:
+  "1"
+`,
+}, {
+	about:   "IsSubsetOfWith: element checker with wrong number of arguments",
+	checker: qt.IsSubsetOfWith(qt.IsNil),
+	got:     []int{1},
+	args:    []interface{}{[]int{1}},
+	expectedCheckFailure: `
+error:
+  bad check: element checker must accept a single argument, not 0
+`,
+	expectedNegateFailure: `
+error:
+  bad check: element checker must accept a single argument, not 0
+`,
+}, {
+	about:   "ContainsAll: success",
+	checker: qt.ContainsAll,
+	got:     []string{"read", "exec", "write"},
+	args:    []interface{}{[]string{"write", "read"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []string{"read", "exec", "write"}
+want:
+  []string{"write", "read"}
+`,
+}, {
+	about:   "ContainsAll: failure",
+	checker: qt.ContainsAll,
+	got:     []string{"read", "exec"},
+	args:    []interface{}{[]string{"write", "read", "admin"}},
+	expectedCheckFailure: `
+error:
+  container does not contain all the elements
+missing:
+  []string{"write", "admin"}
+got:
+  []string{"read", "exec"}
+want:
+  []string{"write", "read", "admin"}
+`,
+}, {
+	about:   "ContainsAllWith: failure",
+	checker: qt.ContainsAllWith(qt.Equals),
+	got:     []interface{}{1, "a"},
+	args:    []interface{}{map[string]interface{}{"x": "a", "y": 2}},
+	expectedCheckFailure: `
+error:
+  container does not contain all the elements
+missing:
+  []interface {}{
+      int(2),
+  }
+got:
+  []interface {}{
+      int(1),
+      "a",
+  }
+want:
+  map[string]interface {}{
+      "x": "a",
+      "y": int(2),
+  }
+`,
+}, {
+	about:   "ContainsExactly: success",
+	checker: qt.ContainsExactly,
+	got:     []int{1, 2, 1, 3},
+	args:    []interface{}{[]int{3, 1, 2, 1}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []int{1, 2, 1, 3}
+want:
+  []int{3, 1, 2, 1}
+`,
+}, {
+	about:   "ContainsExactly: repeated elements",
+	checker: qt.ContainsExactly,
+	got:     []int{1, 2, 1, 3},
+	args:    []interface{}{[]int{3, 1, 2, 2}},
+	expectedCheckFailure: `
+error:
+  containers do not have the same elements
+missing:
+  []int{2}
+unexpected:
+  []int{1}
+got:
+  []int{1, 2, 1, 3}
+want:
+  []int{3, 1, 2, 2}
+`,
+}, {
+	about:   "ContainsExactly: missing and unexpected",
+	checker: qt.ContainsExactly,
+	got:     []string{"a", "b", "c"},
+	args:    []interface{}{[]string{"d", "b", "e", "a"}},
+	expectedCheckFailure: `
+error:
+  containers do not have the same elements
+missing:
+  []string{"d", "e"}
+unexpected:
+  []string{"c"}
+got:
+  []string{"a", "b", "c"}
+want:
+  []string{"d", "b", "e", "a"}
+`,
+}, {
+	about:   "ContainsExactly: missing only",
+	checker: qt.ContainsExactly,
+	got:     []string{"a"},
+	args:    []interface{}{[]string{"a", "b"}},
+	expectedCheckFailure: `
+error:
+  containers do not have the same elements
+missing:
+  []string{"b"}
+got:
+  []string{"a"}
+want:
+  []string{"a", "b"}
+`,
+}, {
+	about:   "ContainsExactlyWith: success",
+	checker: qt.ContainsExactlyWith(qt.Matches),
+	got:     []string{"foo", "bar"},
+	args:    []interface{}{[]string{"b.*", "f.*"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []string{"foo", "bar"}
+want:
+  []string{"b.*", "f.*"}
+`,
+}, {
+	about:   "ContainsExactlyWith: non-transitive checker",
+	checker: qt.ContainsExactlyWith(qt.ApproxEquals(0.5)),
+	got:     []float64{1, 2},
+	args:    []interface{}{[]float64{1.5, 0.75}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []float64{1, 2}
+want:
+  []float64{1.5, 0.75}
+`,
+}, {
+	about:   "ContainsExactlyWith: no valid pairing",
+	checker: qt.ContainsExactlyWith(qt.ApproxEquals(0.5)),
+	got:     []float64{1, 1.2},
+	args:    []interface{}{[]float64{1.5, 0.75, 3}},
+	expectedCheckFailure: `
+error:
+  containers do not have the same elements
+missing:
+  []float64{3}
+got:
+  []float64{1, 1.2}
+want:
+  []float64{1.5, 0.75, 3}
+`,
+}, {
+	about:   "IsDisjoint: success",
+	checker: qt.IsDisjoint,
+	got:     []string{"a", "b"},
+	args:    []interface{}{[]string{"c", "d"}},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []string{"a", "b"}
+want:
+  []string{"c", "d"}
+`,
+}, {
+	about:   "IsDisjoint: failure",
+	checker: qt.IsDisjoint,
+	got:     []string{"a", "b", "c"},
+	args:    []interface{}{[]string{"c", "d", "a"}},
+	expectedCheckFailure: `
+error:
+  containers have elements in common
+common:
+  []string{"a", "c"}
+got:
+  []string{"a", "b", "c"}
+want:
+  []string{"c", "d", "a"}
+`,
+}, {
+	about:   "IsDisjointWith: failure",
+	checker: qt.IsDisjointWith(qt.ErrorMatches),
+	got:     []error{errBadWolf},
+	args:    []interface{}{[]string{"bad.*"}},
+	expectedCheckFailure: `
+error:
+  containers have elements in common
+common:
+  []error{
+      &quicktest_test.errTest{msg:"bad wolf", formatted:true},
+  }
+got:
+  <same as "common">
+want:
+  []string{"bad.*"}
+`,
+}}
@@ -0,0 +1,169 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// HasPrefix is a Checker checking that the provided string starts with the
+// given prefix. The provided value can also be a []byte, an error or
+// a fmt.Stringer. On failure, the longest common prefix is reported.
+//
+// For instance:
+//
+//	c.Assert(err, qt.HasPrefix, "cannot open file: ")
+var HasPrefix Checker = &stringChecker{
+	argNames: []string{"got", "prefix"},
+	check: func(got, prefix string, note func(key string, value interface{})) error {
+		if strings.HasPrefix(got, prefix) {
+			return nil
+		}
+		note("common prefix", commonPrefix(got, prefix, false))
+		return errors.New("value does not have prefix")
+	},
+}
+
+// HasSuffix is a Checker checking that the provided string ends with the
+// given suffix. See HasPrefix for the supported values. On failure, the
+// longest common suffix is reported.
+//
+// For instance:
+//
+//	c.Assert(path, qt.HasSuffix, ".go")
+var HasSuffix Checker = &stringChecker{
+	argNames: []string{"got", "suffix"},
+	check: func(got, suffix string, note func(key string, value interface{})) error {
+		if strings.HasSuffix(got, suffix) {
+			return nil
+		}
+		note("common suffix", commonSuffix(got, suffix))
+		return errors.New("value does not have suffix")
+	},
+}
+
+// EqualFold is a Checker checking that the provided string is equal to the
+// given one under Unicode case-folding. See HasPrefix for the supported
+// values. On failure, the longest common prefix is reported.
+//
+// For instance:
+//
+//	c.Assert(resp.Header.Get("Content-Type"), qt.EqualFold, "application/json")
+var EqualFold Checker = &stringChecker{
+	argNames: []string{"got", "want"},
+	check: func(got, want string, note func(key string, value interface{})) error {
+		if strings.EqualFold(got, want) {
+			return nil
+		}
+		note("common prefix", commonPrefix(got, want, true))
+		return errors.New("values are not equal ignoring case")
+	},
+}
+
+// ContainsFold is a Checker checking that the provided string contains the
+// given substring under Unicode case-folding. See HasPrefix for the supported
+// values.
+//
+// For instance:
+//
+//	c.Assert(output, qt.ContainsFold, "warning")
+var ContainsFold Checker = &stringChecker{
+	argNames: []string{"got", "substring"},
+	check: func(got, substring string, note func(key string, value interface{})) error {
+		if containsFold(got, substring) {
+			return nil
+		}
+		return errors.New("no case-insensitive substring match found")
+	},
+}
+
+type stringChecker struct {
+	argNames
+	check func(got, want string, note func(key string, value interface{})) error
+}
+
+// Check implements Checker.Check by converting got to a string and checking
+// it against args[0] using c.check.
+func (c *stringChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	var s string
+	switch v := got.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case error:
+		s = v.Error()
+	case fmt.Stringer:
+		s = v.String()
+	default:
+		note("got", got)
+		return BadCheckf("value is not a string, a []byte, an error or a fmt.Stringer")
+	}
+	want, ok := args[0].(string)
+	if !ok {
+		note(c.argNames[1], args[0])
+		return BadCheckf("%s is not a string", c.argNames[1])
+	}
+	return c.check(s, want, note)
+}
+
+// commonPrefix returns the longest prefix of a which is also a prefix of b,
+// comparing runes under case-folding if fold is true.
+func commonPrefix(a, b string, fold bool) string {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		ra, na := utf8.DecodeRuneInString(a[i:])
+		rb, nb := utf8.DecodeRuneInString(b[j:])
+		if ra != rb && !(fold && strings.EqualFold(a[i:i+na], b[j:j+nb])) {
+			break
+		}
+		i, j = i+na, j+nb
+	}
+	return a[:i]
+}
+
+// commonSuffix returns the longest suffix of a which is also a suffix of b.
+func commonSuffix(a, b string) string {
+	i, j := len(a), len(b)
+	for i > 0 && j > 0 {
+		ra, na := utf8.DecodeLastRuneInString(a[:i])
+		rb, nb := utf8.DecodeLastRuneInString(b[:j])
+		if ra != rb {
+			break
+		}
+		i, j = i-na, j-nb
+	}
+	return a[i:]
+}
+
+// containsFold reports whether substr is within s under case-folding.
+func containsFold(s, substr string) bool {
+	if substr == "" {
+		return true
+	}
+	for i := range s {
+		if hasPrefixFold(s[i:], substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefixFold reports whether s starts with prefix under case-folding.
+func hasPrefixFold(s, prefix string) bool {
+	for prefix != "" {
+		if s == "" {
+			return false
+		}
+		rs, ns := utf8.DecodeRuneInString(s)
+		rp, np := utf8.DecodeRuneInString(prefix)
+		if rs != rp && !strings.EqualFold(s[:ns], prefix[:np]) {
+			return false
+		}
+		s, prefix = s[ns:], prefix[np:]
+	}
+	return true
+}
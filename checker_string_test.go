@@ -0,0 +1,297 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"bytes"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, stringCheckerTests...)
+}
+
+var stringCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "HasPrefix: success",
+	checker: qt.HasPrefix,
+	got:     "cannot open file: not found",
+	args:    []interface{}{"cannot open file: "},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "cannot open file: not found"
+prefix:
+  "cannot open file: "
+`,
+}, {
+	about:   "HasPrefix: failure",
+	checker: qt.HasPrefix,
+	got:     "cannot open dir: not found",
+	args:    []interface{}{"cannot open file: "},
+	expectedCheckFailure: `
+error:
+  value does not have prefix
+common prefix:
+  "cannot open "
+got:
+  "cannot open dir: not found"
+prefix:
+  "cannot open file: "
+`,
+}, {
+	about:   "HasPrefix: metacharacters",
+	checker: qt.HasPrefix,
+	got:     "[a-z]+ (.*)",
+	args:    []interface{}{"[a-z]+ ("},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "[a-z]+ (.*)"
+prefix:
+  "[a-z]+ ("
+`,
+}, {
+	about:   "HasPrefix: multi-byte runes",
+	checker: qt.HasPrefix,
+	got:     "héllo wörld",
+	args:    []interface{}{"héllo wàrld"},
+	expectedCheckFailure: `
+error:
+  value does not have prefix
+common prefix:
+  "héllo w"
+got:
+  "héllo wörld"
+prefix:
+  "héllo wàrld"
+`,
+}, {
+	about:   "HasPrefix: bytes",
+	checker: qt.HasPrefix,
+	got:     []byte("bad wolf"),
+	args:    []interface{}{"bad"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []uint8("bad wolf")
+prefix:
+  "bad"
+`,
+}, {
+	about:   "HasPrefix: error",
+	checker: qt.HasPrefix,
+	got:     errBadWolf,
+	args:    []interface{}{"bad sheep"},
+	expectedCheckFailure: `
+error:
+  value does not have prefix
+common prefix:
+  "bad "
+got:
+  bad wolf
+    file:line
+prefix:
+  "bad sheep"
+`,
+}, {
+	about:   "HasPrefix: stringer",
+	checker: qt.HasPrefix,
+	got:     bytes.NewBufferString("bad wolf"),
+	args:    []interface{}{"bad"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  s"bad wolf"
+prefix:
+  "bad"
+`,
+}, {
+	about:   "HasPrefix: not a string",
+	checker: qt.HasPrefix,
+	got:     42,
+	args:    []interface{}{"4"},
+	expectedCheckFailure: `
+error:
+  bad check: value is not a string, a []byte, an error or a fmt.Stringer
+got:
+  int(42)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: value is not a string, a []byte, an error or a fmt.Stringer
+got:
+  int(42)
+`,
+}, {
+	about:   "HasPrefix: prefix not a string",
+	checker: qt.HasPrefix,
+	got:     "42",
+	args:    []interface{}{4},
+	expectedCheckFailure: `
+error:
+  bad check: prefix is not a string
+prefix:
+  int(4)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: prefix is not a string
+prefix:
+  int(4)
+`,
+}, {
+	about:   "HasSuffix: success",
+	checker: qt.HasSuffix,
+	got:     "main.go",
+	args:    []interface{}{".go"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "main.go"
+suffix:
+  ".go"
+`,
+}, {
+	about:   "HasSuffix: failure",
+	checker: qt.HasSuffix,
+	got:     "main_test.go",
+	args:    []interface{}{"_tests.go"},
+	expectedCheckFailure: `
+error:
+  value does not have suffix
+common suffix:
+  ".go"
+got:
+  "main_test.go"
+suffix:
+  "_tests.go"
+`,
+}, {
+	about:   "HasSuffix: stringer",
+	checker: qt.HasSuffix,
+	got:     42 * time.Millisecond,
+	args:    []interface{}{"s"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  s"42ms"
+suffix:
+  "s"
+`,
+}, {
+	about:   "EqualFold: success",
+	checker: qt.EqualFold,
+	got:     "Application/JSON",
+	args:    []interface{}{"application/json"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "Application/JSON"
+want:
+  "application/json"
+`,
+}, {
+	about:   "EqualFold: failure",
+	checker: qt.EqualFold,
+	got:     "Application/JSON",
+	args:    []interface{}{"application/xml"},
+	expectedCheckFailure: `
+error:
+  values are not equal ignoring case
+common prefix:
+  "Application/"
+got:
+  "Application/JSON"
+want:
+  "application/xml"
+`,
+}, {
+	about:   "EqualFold: runes with different lengths",
+	checker: qt.EqualFold,
+	got:     "\u212a-Kelvin",
+	args:    []interface{}{"k-kelvins"},
+	expectedCheckFailure: `
+error:
+  values are not equal ignoring case
+common prefix:
+  "K-Kelvin"
+got:
+  <same as "common prefix">
+want:
+  "k-kelvins"
+`,
+}, {
+	about:   "EqualFold: want not a string",
+	checker: qt.EqualFold,
+	got:     "true",
+	args:    []interface{}{true},
+	expectedCheckFailure: `
+error:
+  bad check: want is not a string
+want:
+  bool(true)
+`,
+	expectedNegateFailure: `
+error:
+  bad check: want is not a string
+want:
+  bool(true)
+`,
+}, {
+	about:   "ContainsFold: success",
+	checker: qt.ContainsFold,
+	got:     "WARNING: disk almost full",
+	args:    []interface{}{"Warning"},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  "WARNING: disk almost full"
+substring:
+  "Warning"
+`,
+}, {
+	about:   "ContainsFold: empty substring",
+	checker: qt.ContainsFold,
+	got:     "",
+	args:    []interface{}{""},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  ""
+substring:
+  <same as "got">
+`,
+}, {
+	about:   "ContainsFold: failure",
+	checker: qt.ContainsFold,
+	got:     errBadWolf,
+	args:    []interface{}{"WOLVES"},
+	expectedCheckFailure: `
+error:
+  no case-insensitive substring match found
+got:
+  bad wolf
+    file:line
+substring:
+  "WOLVES"
+`,
+}}
@@ -0,0 +1,158 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields maps struct field paths to the checkers their values must satisfy.
+// A path is a dot separated list of field names, like "Owner.Name". Fields of
+// embedded structs can be referred to directly, as in Go selectors.
+type Fields map[string]Checker
+
+// StructMatches returns a Checker checking that the fields of the provided
+// struct, or pointer to struct, satisfy the given checkers. Fields which are
+// not included are ignored. Pointers are followed when descending into nested
+// fields. Checkers must not require arguments: use Bind to provide them.
+//
+// On failure, all the fields which do not satisfy their checkers are
+// reported.
+//
+// For instance:
+//
+//	c.Assert(user, qt.StructMatches(qt.Fields{
+//		"Name":       qt.Bind(qt.Equals, "bob"),
+//		"CreatedAt":  qt.IsNotZero,
+//		"Owner.Role": qt.Bind(qt.Matches, "admin|owner"),
+//	}))
+func StructMatches(fields Fields) Checker {
+	return &structMatchesChecker{
+		argNames: []string{"got"},
+		fields:   fields,
+	}
+}
+
+type structMatchesChecker struct {
+	argNames
+	fields Fields
+}
+
+// Check implements Checker.Check by checking that the fields of got satisfy
+// c.fields.
+func (c *structMatchesChecker) Check(got interface{}, args []interface{}, notef func(key string, value interface{})) error {
+	v := reflect.ValueOf(got)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return errors.New("got nil pointer")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		notef("got", got)
+		return BadCheckf("first argument is not a struct or a pointer to a struct")
+	}
+	paths := make([]string, 0, len(c.fields))
+	for path := range c.fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// Resolve all the fields first, so that invalid paths are reported
+	// before any failure.
+	values := make([]reflect.Value, len(paths))
+	errs := make([]error, len(paths))
+	for i, path := range paths {
+		if len(c.fields[path].ArgNames()) != 1 {
+			return BadCheckf("checker for field %q requires arguments: use Bind to provide them", path)
+		}
+		values[i], errs[i] = structField(v, path)
+		if IsBadCheck(errs[i]) {
+			notef("field", Unquoted(path))
+			return errs[i]
+		}
+	}
+
+	var failed []string
+	for i, path := range paths {
+		if errs[i] != nil {
+			notef("error", Unquoted(errs[i].Error()))
+			notef("field", Unquoted(path))
+			failed = append(failed, path)
+			continue
+		}
+		err := checkNested(c.fields[path], values[i].Interface(), nil, "value", notef, note{"field", Unquoted(path)})
+		if IsBadCheck(err) {
+			return err
+		}
+		if err != nil {
+			failed = append(failed, path)
+		}
+	}
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("field %q does not satisfy checker", failed[0])
+	}
+	return fmt.Errorf("%d fields do not satisfy their checkers", len(failed))
+}
+
+// structField returns the value of the field with the given path in the given
+// struct. A BadCheck error is returned if the path is not valid for the struct
+// type, and a regular error if a nil pointer is found while following it.
+func structField(v reflect.Value, path string) (reflect.Value, error) {
+	// The path is always validated against the types, so that invalid paths
+	// are reported even if a nil pointer is found along the way.
+	var nilErr error
+	t := v.Type()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		if name == "" {
+			return reflect.Value{}, BadCheckf("invalid field path %q", path)
+		}
+		for t.Kind() == reflect.Ptr {
+			if nilErr == nil && v.IsNil() {
+				nilErr = fmt.Errorf("%s is a nil pointer", strings.Join(names[:i], "."))
+			}
+			if nilErr == nil {
+				v = v.Elem()
+			}
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return reflect.Value{}, BadCheckf("%s is not a struct", strings.Join(names[:i], "."))
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return reflect.Value{}, BadCheckf("field %q not found in %s", name, t)
+		}
+		if f.PkgPath != "" {
+			return reflect.Value{}, BadCheckf("cannot access unexported field %q", name)
+		}
+		// Walk through embedded structs, dereferencing pointers.
+		for j, index := range f.Index {
+			if j > 0 && t.Kind() == reflect.Ptr {
+				if nilErr == nil && v.IsNil() {
+					nilErr = fmt.Errorf("embedded field %s is a nil pointer", t.Elem().Name())
+				}
+				if nilErr == nil {
+					v = v.Elem()
+				}
+				t = t.Elem()
+			}
+			if nilErr == nil {
+				v = v.Field(index)
+			}
+			t = t.Field(index).Type
+		}
+	}
+	if nilErr != nil {
+		return reflect.Value{}, nilErr
+	}
+	return v, nil
+}
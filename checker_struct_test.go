@@ -0,0 +1,342 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, structCheckerTests...)
+}
+
+type structOwner struct {
+	Name string
+	Role string
+}
+
+type structBase struct {
+	ID int
+}
+
+type structRecord struct {
+	structBase
+	Title string
+	Tags  []string
+	Owner *structOwner
+	count int
+}
+
+type structRecordPtr struct {
+	*structBase
+	Title string
+}
+
+var structCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about: "StructMatches: success",
+	checker: qt.StructMatches(qt.Fields{
+		"Title":      qt.Bind(qt.Equals, "report"),
+		"ID":         qt.IsNotZero,
+		"Owner.Name": qt.Bind(qt.Matches, "b.*"),
+	}),
+	got: structRecord{
+		structBase: structBase{ID: 47},
+		Title:      "report",
+		Tags:       []string{"a"},
+		Owner:      &structOwner{Name: "bob"},
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  quicktest_test.structRecord{
+      structBase: quicktest_test.structBase{ID:47},
+      Title:      "report",
+      Tags:       {"a"},
+      Owner:      &quicktest_test.structOwner{Name:"bob", Role:""},
+      count:      0,
+  }
+`,
+}, {
+	about: "StructMatches: pointer to struct",
+	checker: qt.StructMatches(qt.Fields{
+		"Owner.Role": qt.Bind(qt.Equals, "admin"),
+	}),
+	got: &structRecord{
+		Owner: &structOwner{Role: "admin"},
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  &quicktest_test.structRecord{
+      structBase: quicktest_test.structBase{},
+      Title:      "",
+      Tags:       nil,
+      Owner:      &quicktest_test.structOwner{Name:"", Role:"admin"},
+      count:      0,
+  }
+`,
+}, {
+	about: "StructMatches: field failure",
+	checker: qt.StructMatches(qt.Fields{
+		"Title": qt.Bind(qt.Equals, "report"),
+		"ID":    qt.IsNotZero,
+	}),
+	got: structRecord{
+		structBase: structBase{ID: 47},
+		Title:      "summary",
+	},
+	expectedCheckFailure: `
+error:
+  field "Title" does not satisfy checker
+error:
+  values are not equal
+field:
+  Title
+value:
+  "summary"
+want:
+  "report"
+got:
+  quicktest_test.structRecord{
+      structBase: quicktest_test.structBase{ID:47},
+      Title:      "summary",
+      Tags:       nil,
+      Owner:      (*quicktest_test.structOwner)(nil),
+      count:      0,
+  }
+`,
+}, {
+	about: "StructMatches: multiple failures",
+	checker: qt.StructMatches(qt.Fields{
+		"Title":      qt.Bind(qt.Equals, "report"),
+		"Tags":       qt.Bind(qt.HasLen, 2),
+		"Owner.Name": qt.Bind(qt.Equals, "bob"),
+		"ID":         qt.IsZero,
+	}),
+	got: structRecord{
+		structBase: structBase{ID: 47},
+		Title:      "report",
+		Tags:       []string{"a"},
+		Owner:      &structOwner{Name: "alice"},
+	},
+	expectedCheckFailure: `
+error:
+  3 fields do not satisfy their checkers
+error:
+  got non-zero value
+field:
+  ID
+value:
+  int(47)
+error:
+  values are not equal
+field:
+  Owner.Name
+value:
+  "alice"
+want:
+  "bob"
+error:
+  unexpected length
+field:
+  Tags
+value:
+  []string{"a"}
+len(got):
+  int(1)
+want length:
+  int(2)
+got:
+  quicktest_test.structRecord{
+      structBase: quicktest_test.structBase{ID:47},
+      Title:      "report",
+      Tags:       {"a"},
+      Owner:      &quicktest_test.structOwner{Name:"alice", Role:""},
+      count:      0,
+  }
+`,
+}, {
+	about: "StructMatches: nil pointer field",
+	checker: qt.StructMatches(qt.Fields{
+		"Owner.Name": qt.Bind(qt.Equals, "bob"),
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  field "Owner.Name" does not satisfy checker
+error:
+  Owner is a nil pointer
+field:
+  Owner.Name
+got:
+  quicktest_test.structRecord{}
+`,
+}, {
+	about: "StructMatches: nil embedded pointer",
+	checker: qt.StructMatches(qt.Fields{
+		"ID":    qt.Bind(qt.Equals, 1),
+		"Title": qt.Bind(qt.Equals, "report"),
+	}),
+	got: structRecordPtr{Title: "report"},
+	expectedCheckFailure: `
+error:
+  field "ID" does not satisfy checker
+error:
+  embedded field structBase is a nil pointer
+field:
+  ID
+got:
+  quicktest_test.structRecordPtr{
+      structBase: (*quicktest_test.structBase)(nil),
+      Title:      "report",
+  }
+`,
+}, {
+	about: "StructMatches: nil pointer",
+	checker: qt.StructMatches(qt.Fields{
+		"Title": qt.Bind(qt.Equals, "report"),
+	}),
+	got: (*structRecord)(nil),
+	expectedCheckFailure: `
+error:
+  got nil pointer
+got:
+  (*quicktest_test.structRecord)(nil)
+`,
+}, {
+	about: "StructMatches: not a struct",
+	checker: qt.StructMatches(qt.Fields{
+		"Title": qt.Bind(qt.Equals, "report"),
+	}),
+	got: map[string]string{"Title": "report"},
+	expectedCheckFailure: `
+error:
+  bad check: first argument is not a struct or a pointer to a struct
+got:
+  map[string]string{"Title":"report"}
+`,
+	expectedNegateFailure: `
+error:
+  bad check: first argument is not a struct or a pointer to a struct
+got:
+  map[string]string{"Title":"report"}
+`,
+}, {
+	about: "StructMatches: field not found",
+	checker: qt.StructMatches(qt.Fields{
+		"Owner.Email": qt.IsZero,
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: field "Email" not found in quicktest_test.structOwner
+field:
+  Owner.Email
+`,
+	expectedNegateFailure: `
+error:
+  bad check: field "Email" not found in quicktest_test.structOwner
+field:
+  Owner.Email
+`,
+}, {
+	about: "StructMatches: unexported field",
+	checker: qt.StructMatches(qt.Fields{
+		"count": qt.IsZero,
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: cannot access unexported field "count"
+field:
+  count
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot access unexported field "count"
+field:
+  count
+`,
+}, {
+	about: "StructMatches: field is not a struct",
+	checker: qt.StructMatches(qt.Fields{
+		"Title.Len": qt.IsZero,
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: Title is not a struct
+field:
+  Title.Len
+`,
+	expectedNegateFailure: `
+error:
+  bad check: Title is not a struct
+field:
+  Title.Len
+`,
+}, {
+	about: "StructMatches: invalid path",
+	checker: qt.StructMatches(qt.Fields{
+		"Owner..Name": qt.IsZero,
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: invalid field path "Owner..Name"
+field:
+  Owner..Name
+`,
+	expectedNegateFailure: `
+error:
+  bad check: invalid field path "Owner..Name"
+field:
+  Owner..Name
+`,
+}, {
+	about: "StructMatches: checker requires arguments",
+	checker: qt.StructMatches(qt.Fields{
+		"Title": qt.Equals,
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: checker for field "Title" requires arguments: use Bind to provide them
+`,
+	expectedNegateFailure: `
+error:
+  bad check: checker for field "Title" requires arguments: use Bind to provide them
+`,
+}, {
+	about: "StructMatches: nested bad check",
+	checker: qt.StructMatches(qt.Fields{
+		"Title": qt.Bind(qt.HasLen, "bad"),
+	}),
+	got: structRecord{},
+	expectedCheckFailure: `
+error:
+  bad check: length is not an int
+length:
+  "bad"
+field:
+  Title
+`,
+	expectedNegateFailure: `
+error:
+  bad check: length is not an int
+length:
+  "bad"
+field:
+  Title
+`,
+}}
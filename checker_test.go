@@ -268,6 +268,42 @@ got:
 want:
   <same as "got" but different pointer value>
 `,
+}, {
+	about:   "Equals: wrapped error is not nil",
+	checker: qt.Equals,
+	got:     fmt.Errorf("cannot load: %w", targetErr),
+	args:    []interface{}{nil},
+	expectedCheckFailure: `
+error:
+  got non-nil error
+error chain:
+  *fmt.wrapError: "cannot load: ptr: target"
+    *quicktest_test.errTarget: "ptr: target"
+got:
+  e"cannot load: ptr: target"
+want:
+  nil
+`,
+}, {
+	about:   "Equals: different wrapped errors",
+	checker: qt.Equals,
+	got:     fmt.Errorf("cannot load: %w", targetErr),
+	args:    []interface{}{errors.New("cannot load: ptr: target")},
+	expectedCheckFailure: `
+error:
+  values are not equal
+got type:
+  *fmt.wrapError
+want type:
+  *errors.errorString
+error chain:
+  *fmt.wrapError: "cannot load: ptr: target"
+    *quicktest_test.errTarget: "ptr: target"
+got:
+  e"cannot load: ptr: target"
+want:
+  <same as "got" but different pointer value>
+`,
 }, {
 	about:   "Equals: different pointer errors with the same message",
 	checker: qt.Equals,
@@ -868,6 +904,75 @@ want:
       Nums: {4, 3, 2, 1},
   }
 `,
+}, {
+	about:   "ContentEquals: same contents on slices of slices",
+	checker: qt.ContentEquals,
+	got:     [][]string{{"a", "b"}, {"c"}, {"b", "a"}},
+	args: []interface{}{
+		[][]string{{"c"}, {"a", "b"}, {"a", "b"}},
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  [][]string{
+      {"a", "b"},
+      {"c"},
+      {"b", "a"},
+  }
+want:
+  [][]string{
+      {"c"},
+      {"a", "b"},
+      {"a", "b"},
+  }
+`,
+}, {
+	about:   "ContentEquals: same contents on slices of maps",
+	checker: qt.ContentEquals,
+	got:     []map[string]int{{"a": 1, "b": 2}, {"c": 3}},
+	args: []interface{}{
+		[]map[string]int{{"c": 3}, {"b": 2, "a": 1}},
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []map[string]int{
+      {"a":1, "b":2},
+      {"c":3},
+  }
+want:
+  []map[string]int{
+      {"c":3},
+      {"a":1, "b":2},
+  }
+`,
+}, {
+	about:   "ContentEquals: same contents with different dynamic types",
+	checker: qt.ContentEquals,
+	got:     []interface{}{int64(1), 1, "1", nil},
+	args: []interface{}{
+		[]interface{}{nil, 1, "1", int64(1)},
+	},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []interface {}{
+      int64(1),
+      int(1),
+      "1",
+      nil,
+  }
+want:
+  []interface {}{
+      nil,
+      int(1),
+      "1",
+      int64(1),
+  }
+`,
 }, {
 	about:   "ContentEquals: slices of different type",
 	checker: qt.ContentEquals,
@@ -2572,6 +2677,94 @@ got args:
 want args:
   want
 `,
+}, {
+	about:   "Bind: success",
+	checker: qt.Bind(qt.Equals, 42),
+	got:     42,
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  int(42)
+`,
+}, {
+	about:   "Bind: failure",
+	checker: qt.Bind(qt.Equals, 42),
+	got:     47,
+	expectedCheckFailure: `
+error:
+  values are not equal
+want:
+  int(42)
+got:
+  int(47)
+`,
+}, {
+	about:   "Bind: silent failure",
+	checker: qt.Bind(qt.DeepEquals, []int{42}),
+	got:     []int{42},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  []int{42}
+`,
+}, {
+	about:   "Bind: checker without arguments",
+	checker: qt.Bind(qt.IsNil),
+	got:     errBadWolf,
+	expectedCheckFailure: `
+error:
+  got non-nil error
+got:
+  bad wolf
+    file:line
+`,
+}, {
+	about:   "Bind: not enough arguments",
+	checker: qt.Bind(qt.Equals),
+	got:     42,
+	expectedCheckFailure: `
+error:
+  bad check: not enough arguments bound to checker: got 0, want 1
+`,
+	expectedNegateFailure: `
+error:
+  bad check: not enough arguments bound to checker: got 0, want 1
+`,
+}, {
+	about:   "Bind: too many arguments",
+	checker: qt.Bind(qt.Equals, 42, 47),
+	got:     42,
+	expectedCheckFailure: `
+error:
+  bad check: too many arguments bound to checker: got 2, want 1
+`,
+	expectedNegateFailure: `
+error:
+  bad check: too many arguments bound to checker: got 2, want 1
+`,
+}, {
+	about:   "Bind: too many arguments provided to the bound checker",
+	checker: qt.Bind(qt.Equals, 42),
+	got:     42,
+	args:    []interface{}{42},
+	expectedCheckFailure: `
+error:
+  bad check: too many arguments provided to checker: got 1, want 0
+got args:
+  []interface {}{
+      int(42),
+  }
+`,
+	expectedNegateFailure: `
+error:
+  bad check: too many arguments provided to checker: got 1, want 0
+got args:
+  []interface {}{
+      int(42),
+  }
+`,
 }, {
 	about:   "Contains with string",
 	checker: qt.Contains,
@@ -3013,17 +3206,139 @@ want:
   json.RawMessage("null")
 `,
 }, {
-	about:   "JSONEquals with bad type",
+	about:   "JSONEquals with Go value",
 	checker: qt.JSONEquals,
 	got:     0,
 	args:    []interface{}{nil},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  float64(0)
+want:
+  nil
+`, diff(0.0, nil)),
+}, {
+	about:   "JSONEquals with struct and encoded want",
+	checker: qt.JSONEquals,
+	got: &OuterJSON{
+		First:  47.11,
+		Second: []*InnerJSON{{First: "hello"}},
+	},
+	args: []interface{}{qt.Encoded(`{"First": 47.11, "Last": [{"First": "hello"}]}`)},
+	expectedNegateFailure: `
+error:
+  unexpected success
+got:
+  &quicktest_test.OuterJSON{
+      First:  47.11,
+      Second: {
+          &quicktest_test.InnerJSON{
+              First:  "hello",
+              Second: 0,
+              Third:  {},
+          },
+      },
+  }
+want:
+  "{\"First\": 47.11, \"Last\": [{\"First\": \"hello\"}]}"
+`,
+}, {
+	about:   "JSONEquals with encoded got and want",
+	checker: qt.JSONEquals,
+	got:     qt.Encoded(`[1, 2]`),
+	args:    []interface{}{qt.Encoded(`[1, 3]`)},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  []interface {}{
+      float64(1),
+      float64(2),
+  }
+want:
+  []interface {}{
+      float64(1),
+      float64(3),
+  }
+`, diff([]interface{}{1.0, 2.0}, []interface{}{1.0, 3.0})),
+}, {
+	about:   "JSONEquals cannot marshal obtained value",
+	checker: qt.JSONEquals,
+	got:     jsonErrorMarshaler{},
+	args:    []interface{}{nil},
 	expectedCheckFailure: `
 error:
-  bad check: expected string or byte, got int
+  bad check: cannot marshal obtained contents: json: error calling MarshalJSON for type *quicktest_test.jsonErrorMarshaler: qt json marshal error
 `,
 	expectedNegateFailure: `
 error:
-  bad check: expected string or byte, got int
+  bad check: cannot marshal obtained contents: json: error calling MarshalJSON for type *quicktest_test.jsonErrorMarshaler: qt json marshal error
+`,
+}, {
+	about:   "JSONEquals with invalid encoded want",
+	checker: qt.JSONEquals,
+	got:     `{}`,
+	args:    []interface{}{qt.Encoded(`{`)},
+	expectedCheckFailure: `
+error:
+  bad check: cannot unmarshal expected contents: unexpected end of JSON input
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot unmarshal expected contents: unexpected end of JSON input
+`,
+}, {
+	about:   "CodecEquals with JSON numbers",
+	checker: qt.CodecEquals(json.Marshal, qt.UnmarshalJSONNumbers),
+	got:     `{"id": 9007199254740993}`,
+	args:    []interface{}{map[string]int64{"id": 9007199254740993}},
+	expectedNegateFailure: tilde2bq(`
+error:
+  unexpected success
+got:
+  ~{"id": 9007199254740993}~
+want:
+  map[string]int64{"id":9007199254740993}
+`),
+}, {
+	about:   "CodecEquals with JSON numbers mismatch",
+	checker: qt.CodecEquals(json.Marshal, qt.UnmarshalJSONNumbers),
+	got:     `{"id": 9007199254740993}`,
+	args:    []interface{}{map[string]int64{"id": 9007199254740992}},
+	expectedCheckFailure: fmt.Sprintf(`
+error:
+  values are not deep equal
+diff (-want +got):
+%s
+got:
+  map[string]interface {}{
+      "id": "9007199254740993",
+  }
+want:
+  map[string]interface {}{
+      "id": "9007199254740992",
+  }
+`, diff(
+		map[string]interface{}{"id": json.Number("9007199254740993")},
+		map[string]interface{}{"id": json.Number("9007199254740992")},
+	)),
+}, {
+	about:   "CodecEquals with JSON numbers and trailing data",
+	checker: qt.CodecEquals(json.Marshal, qt.UnmarshalJSONNumbers),
+	got:     `{} {}`,
+	args:    []interface{}{map[string]int64{}},
+	expectedCheckFailure: `
+error:
+  cannot unmarshal obtained contents: invalid data after top-level value; "{} {}"
+got:
+  "{} {}"
+want:
+  map[string]int64{}
 `,
 }, {
 	about: "CodecEquals with bad marshal",
@@ -3085,6 +3400,39 @@ func TestCheckers(t *testing.T) {
 	}
 }
 
+func TestContentEqualsLargeSlices(t *testing.T) {
+	type item struct {
+		ID   int
+		Tags []string
+	}
+	got := make([]item, 2000)
+	want := make([]item, len(got))
+	for i := range got {
+		got[i] = item{ID: i, Tags: []string{"a", fmt.Sprint(i % 7)}}
+		want[len(want)-1-i] = item{ID: i, Tags: []string{fmt.Sprint(i % 7), "a"}}
+	}
+	tt := &testingT{}
+	c := qt.New(tt)
+	assertBool(t, c.Check(got, qt.ContentEquals, want), true)
+	want[0].Tags[0] = "b"
+	assertBool(t, c.Check(got, qt.ContentEquals, want), false)
+}
+
+type cyclicNode struct {
+	Name string
+	Next *cyclicNode
+}
+
+func TestContentEqualsCyclicValues(t *testing.T) {
+	a, b := &cyclicNode{Name: "a"}, &cyclicNode{Name: "b"}
+	a.Next, b.Next = b, a
+	tt := &testingT{}
+	c := qt.New(tt)
+	ok := c.Check([]*cyclicNode{a, b}, qt.ContentEquals, []*cyclicNode{b, a})
+	assertBool(t, ok, true)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+}
+
 func diff(got, want interface{}, opts ...cmp.Option) string {
 	d := cmp.Diff(want, got, opts...)
 	return strings.TrimSuffix(qt.Prefixf("  ", "%s", d), "\n")
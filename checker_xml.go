@@ -0,0 +1,363 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// XMLEquals is a Checker checking that a byte slice or string contains XML
+// which is equivalent to the given XML document. The wanted document can be
+// provided as a byte slice or string, or as a Go value, in which case it is
+// marshaled with encoding/xml.
+//
+// Both documents are parsed into canonical element trees before comparing
+// them: the order of attributes, the prefixes used for namespaces,
+// whitespace-only text and the whitespace surrounding text are ignored, as
+// well as comments and processing instructions.
+//
+// On failure, each difference is reported with an XPath-like location.
+//
+// For instance:
+//
+//	c.Assert(feed, qt.XMLEquals, `<feed xmlns="http://www.w3.org/2005/Atom"><title>news</title></feed>`)
+var XMLEquals Checker = &xmlEqualsChecker{
+	argNames: []string{"got", "want"},
+}
+
+type xmlEqualsChecker struct {
+	argNames
+}
+
+// Check implements Checker.Check by checking that got and args[0] are
+// equivalent XML documents.
+func (c *xmlEqualsChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	var gotData []byte
+	switch got := got.(type) {
+	case string:
+		gotData = []byte(got)
+	case []byte:
+		gotData = got
+	default:
+		return BadCheckf("expected string or byte, got %T", got)
+	}
+	var wantData []byte
+	switch want := args[0].(type) {
+	case string:
+		wantData = []byte(want)
+	case []byte:
+		wantData = want
+	default:
+		var err error
+		if wantData, err = xml.Marshal(want); err != nil {
+			return BadCheckf("cannot marshal expected contents: %v", err)
+		}
+	}
+	wantRoot, err := parseXML(wantData)
+	if err != nil {
+		return BadCheckf("cannot parse expected contents: %v", err)
+	}
+	gotRoot, err := parseXML(gotData)
+	if err != nil {
+		return fmt.Errorf("cannot parse obtained contents: %v; %q", err, gotData)
+	}
+
+	var diffs []xmlDiff
+	if gotRoot.name != wantRoot.name {
+		diffs = append(diffs, xmlDiff{
+			path:   "/",
+			reason: "root elements are not equal",
+			got:    gotRoot,
+			want:   wantRoot,
+		})
+	} else {
+		compareXML(gotRoot, wantRoot, "/"+xmlName(wantRoot.name), &diffs)
+	}
+	for _, d := range diffs {
+		note("error", Unquoted(d.reason))
+		note("path", Unquoted(d.path))
+		if d.got != nil {
+			note("got value", xmlText(d.got))
+		}
+		if d.want != nil {
+			note("want value", xmlText(d.want))
+		}
+	}
+	switch len(diffs) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("XML node at %s does not match", diffs[0].path)
+	}
+	return fmt.Errorf("%d XML nodes do not match", len(diffs))
+}
+
+// xmlNode is a node of a canonical XML element tree. Element nodes have a
+// name, and text nodes only hold text.
+type xmlNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     string
+}
+
+// xmlDiff holds a difference found by compareXML. The got and want fields
+// hold either a node or an attribute value, and they are nil if the node or
+// attribute is missing from the corresponding document.
+type xmlDiff struct {
+	path      string
+	reason    string
+	got, want interface{}
+}
+
+// parseXML parses the given XML document into a canonical element tree and
+// returns its root element.
+func parseXML(data []byte) (*xmlNode, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			n := &xmlNode{
+				name: tok.Name,
+			}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					// Namespace declarations are already resolved in names.
+					continue
+				}
+				n.attrs = append(n.attrs, attr)
+			}
+			sort.Slice(n.attrs, func(i, j int) bool {
+				return xmlName(n.attrs[i].Name) < xmlName(n.attrs[j].Name)
+			})
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else if root != nil {
+				return nil, errors.New("multiple root elements")
+			} else {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack[len(stack)-1].trimText()
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) == 0 {
+				if strings.TrimSpace(string(tok)) != "" {
+					return nil, errors.New("text outside of the root element")
+				}
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if last := len(parent.children) - 1; last >= 0 && parent.children[last].isText() {
+				// Join the text split by comments or processing instructions.
+				// Whitespace is trimmed once the element is complete.
+				parent.children[last].text += string(tok)
+				continue
+			}
+			parent.children = append(parent.children, &xmlNode{
+				text: string(tok),
+			})
+		}
+	}
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+	return root, nil
+}
+
+// trimText trims the whitespace surrounding the text children of the node,
+// and removes the whitespace-only ones.
+func (n *xmlNode) trimText() {
+	children := n.children[:0]
+	for _, child := range n.children {
+		if child.isText() {
+			child.text = strings.TrimSpace(child.text)
+			if child.text == "" {
+				continue
+			}
+		}
+		children = append(children, child)
+	}
+	n.children = children
+}
+
+// isText reports whether the node is a text node.
+func (n *xmlNode) isText() bool {
+	return n.name.Local == ""
+}
+
+// compareXML appends to diffs the differences between the given elements,
+// which have the same name and are found at the given path.
+func compareXML(got, want *xmlNode, path string, diffs *[]xmlDiff) {
+	gotAttrs := make(map[xml.Name]string, len(got.attrs))
+	for _, attr := range got.attrs {
+		gotAttrs[attr.Name] = attr.Value
+	}
+	wantAttrs := make(map[xml.Name]string, len(want.attrs))
+	for _, attr := range want.attrs {
+		wantAttrs[attr.Name] = attr.Value
+		p := path + "/@" + xmlName(attr.Name)
+		v, ok := gotAttrs[attr.Name]
+		switch {
+		case !ok:
+			*diffs = append(*diffs, xmlDiff{
+				path:   p,
+				reason: "attribute not found",
+				want:   attr.Value,
+			})
+		case v != attr.Value:
+			*diffs = append(*diffs, xmlDiff{
+				path:   p,
+				reason: "attribute values are not equal",
+				got:    v,
+				want:   attr.Value,
+			})
+		}
+	}
+	for _, attr := range got.attrs {
+		if _, ok := wantAttrs[attr.Name]; !ok {
+			*diffs = append(*diffs, xmlDiff{
+				path:   path + "/@" + xmlName(attr.Name),
+				reason: "unexpected attribute",
+				got:    attr.Value,
+			})
+		}
+	}
+
+	gotPaths, wantPaths := childPaths(got, path), childPaths(want, path)
+	for i := 0; i < len(got.children) || i < len(want.children); i++ {
+		switch {
+		case i >= len(got.children):
+			*diffs = append(*diffs, xmlDiff{
+				path:   wantPaths[i],
+				reason: "node not found",
+				want:   want.children[i],
+			})
+		case i >= len(want.children):
+			*diffs = append(*diffs, xmlDiff{
+				path:   gotPaths[i],
+				reason: "unexpected node",
+				got:    got.children[i],
+			})
+		default:
+			g, w := got.children[i], want.children[i]
+			switch {
+			case g.isText() && w.isText():
+				if g.text != w.text {
+					*diffs = append(*diffs, xmlDiff{
+						path:   wantPaths[i],
+						reason: "text is not equal",
+						got:    g.text,
+						want:   w.text,
+					})
+				}
+			case g.name == w.name:
+				compareXML(g, w, wantPaths[i], diffs)
+			default:
+				*diffs = append(*diffs, xmlDiff{
+					path:   wantPaths[i],
+					reason: "nodes are not equal",
+					got:    g,
+					want:   w,
+				})
+			}
+		}
+	}
+}
+
+// childPaths returns the XPath-like locations of the children of the given
+// element, found at the given path. Positions are only included when there
+// are multiple siblings with the same name.
+func childPaths(n *xmlNode, path string) []string {
+	counts := make(map[xml.Name]int)
+	for _, child := range n.children {
+		counts[child.name]++
+	}
+	positions := make(map[xml.Name]int)
+	paths := make([]string, len(n.children))
+	for i, child := range n.children {
+		positions[child.name]++
+		p := path + "/"
+		if child.isText() {
+			p += "text()"
+		} else {
+			p += xmlName(child.name)
+		}
+		if counts[child.name] > 1 {
+			p += "[" + strconv.Itoa(positions[child.name]) + "]"
+		}
+		paths[i] = p
+	}
+	return paths
+}
+
+// xmlName returns the string representation of the given name, including the
+// namespace in braces if present.
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// xmlText returns the representation of the given node or attribute value
+// used when reporting differences.
+func xmlText(v interface{}) interface{} {
+	n, ok := v.(*xmlNode)
+	if !ok {
+		return v
+	}
+	if n.isText() {
+		return n.text
+	}
+	var buf bytes.Buffer
+	writeXML(&buf, n, "")
+	return Unquoted(buf.String())
+}
+
+// writeXML writes the canonical representation of the given element, whose
+// parent is in the given namespace.
+func writeXML(buf *bytes.Buffer, n *xmlNode, space string) {
+	if n.isText() {
+		xml.EscapeText(buf, []byte(n.text))
+		return
+	}
+	buf.WriteString("<" + n.name.Local)
+	if n.name.Space != space {
+		buf.WriteString(` xmlns="`)
+		xml.EscapeText(buf, []byte(n.name.Space))
+		buf.WriteByte('"')
+	}
+	for _, attr := range n.attrs {
+		buf.WriteString(" " + xmlName(attr.Name) + `="`)
+		xml.EscapeText(buf, []byte(attr.Value))
+		buf.WriteByte('"')
+	}
+	if len(n.children) == 0 {
+		buf.WriteString("/>")
+		return
+	}
+	buf.WriteByte('>')
+	for _, child := range n.children {
+		writeXML(buf, child, n.name.Space)
+	}
+	buf.WriteString("</" + n.name.Local + ">")
+}
@@ -0,0 +1,286 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"encoding/xml"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, xmlCheckerTests...)
+}
+
+type xmlItem struct {
+	XMLName xml.Name `xml:"item"`
+	ID      int      `xml:"id,attr"`
+	Name    string   `xml:"name"`
+}
+
+var xmlCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "XMLEquals: same document",
+	checker: qt.XMLEquals,
+	got:     `<a><b id="1">text</b></a>`,
+	args:    []interface{}{`<a><b id="1">text</b></a>`},
+	expectedNegateFailure: tilde2bq(`
+error:
+  unexpected success
+got:
+  ~<a><b id="1">text</b></a>~
+want:
+  <same as "got">
+`),
+}, {
+	about:   "XMLEquals: canonicalisation",
+	checker: qt.XMLEquals,
+	got: []byte(`<?xml version="1.0"?>
+<s:Envelope xmlns:s="urn:soap" b="2" a="1">
+	<!-- comment -->
+	<s:Body>
+		<item>  hello  </item>
+	</s:Body>
+</s:Envelope>
+`),
+	args: []interface{}{`<Envelope xmlns="urn:soap" a="1" b="2"><Body><item xmlns="">hello</item></Body></Envelope>`},
+	expectedNegateFailure: tilde2bq(`
+error:
+  unexpected success
+got:
+  []uint8("<?xml version=\"1.0\"?>\n<s:Envelope xmlns:s=\"urn:soap\" b=\"2\" a=\"1\">\n\t<!-- comment -->\n\t<s:Body>\n\t\t<item>  hello  </item>\n\t</s:Body>\n</s:Envelope>\n")
+want:
+  ~<Envelope xmlns="urn:soap" a="1" b="2"><Body><item xmlns="">hello</item></Body></Envelope>~
+`),
+}, {
+	about:   "XMLEquals: Go value",
+	checker: qt.XMLEquals,
+	got:     `<item id="47"><name>bob</name></item>`,
+	args:    []interface{}{xmlItem{ID: 47, Name: "bob"}},
+	expectedNegateFailure: tilde2bq(`
+error:
+  unexpected success
+got:
+  ~<item id="47"><name>bob</name></item>~
+want:
+  quicktest_test.xmlItem{
+      XMLName: xml.Name{},
+      ID:      47,
+      Name:    "bob",
+  }
+`),
+}, {
+	about:   "XMLEquals: text split by a comment",
+	checker: qt.XMLEquals,
+	got:     `<a>foo<!--x--> bar</a>`,
+	args:    []interface{}{`<a>foobar</a>`},
+	expectedCheckFailure: `
+error:
+  XML node at /a/text() does not match
+error:
+  text is not equal
+path:
+  /a/text()
+got value:
+  "foo bar"
+want value:
+  "foobar"
+got:
+  "<a>foo<!--x--> bar</a>"
+want:
+  "<a>foobar</a>"
+`,
+}, {
+	about:   "XMLEquals: text mismatch",
+	checker: qt.XMLEquals,
+	got:     `<a><b>one</b><b>two</b></a>`,
+	args:    []interface{}{`<a><b>one</b><b>three</b></a>`},
+	expectedCheckFailure: `
+error:
+  XML node at /a/b[2]/text() does not match
+error:
+  text is not equal
+path:
+  /a/b[2]/text()
+got value:
+  "two"
+want value:
+  "three"
+got:
+  "<a><b>one</b><b>two</b></a>"
+want:
+  "<a><b>one</b><b>three</b></a>"
+`,
+}, {
+	about:   "XMLEquals: attribute mismatches",
+	checker: qt.XMLEquals,
+	got:     `<a x="1" y="2" z="3"/>`,
+	args:    []interface{}{`<a x="1" y="0" w="4"/>`},
+	expectedCheckFailure: tilde2bq(`
+error:
+  3 XML nodes do not match
+error:
+  attribute not found
+path:
+  /a/@w
+want value:
+  "4"
+error:
+  attribute values are not equal
+path:
+  /a/@y
+got value:
+  "2"
+want value:
+  "0"
+error:
+  unexpected attribute
+path:
+  /a/@z
+got value:
+  "3"
+got:
+  ~<a x="1" y="2" z="3"/>~
+want:
+  ~<a x="1" y="0" w="4"/>~
+`),
+}, {
+	about:   "XMLEquals: namespace mismatch",
+	checker: qt.XMLEquals,
+	got:     `<a xmlns:p="urn:one"><p:b/></a>`,
+	args:    []interface{}{`<a xmlns:p="urn:two"><p:b/></a>`},
+	expectedCheckFailure: tilde2bq(`
+error:
+  XML node at /a/{urn:two}b does not match
+error:
+  nodes are not equal
+path:
+  /a/{urn:two}b
+got value:
+  <b xmlns="urn:one"/>
+want value:
+  <b xmlns="urn:two"/>
+got:
+  ~<a xmlns:p="urn:one"><p:b/></a>~
+want:
+  ~<a xmlns:p="urn:two"><p:b/></a>~
+`),
+}, {
+	about:   "XMLEquals: missing and unexpected nodes",
+	checker: qt.XMLEquals,
+	got:     `<a><b/></a>`,
+	args:    []interface{}{`<a><b/><c>text</c></a>`},
+	expectedCheckFailure: `
+error:
+  XML node at /a/c does not match
+error:
+  node not found
+path:
+  /a/c
+want value:
+  <c>text</c>
+got:
+  "<a><b/></a>"
+want:
+  "<a><b/><c>text</c></a>"
+`,
+}, {
+	about:   "XMLEquals: unexpected node",
+	checker: qt.XMLEquals,
+	got:     `<a><b/>text</a>`,
+	args:    []interface{}{`<a><b/></a>`},
+	expectedCheckFailure: `
+error:
+  XML node at /a/text() does not match
+error:
+  unexpected node
+path:
+  /a/text()
+got value:
+  "text"
+got:
+  "<a><b/>text</a>"
+want:
+  "<a><b/></a>"
+`,
+}, {
+	about:   "XMLEquals: root mismatch",
+	checker: qt.XMLEquals,
+	got:     `<a/>`,
+	args:    []interface{}{`<b/>`},
+	expectedCheckFailure: `
+error:
+  XML node at / does not match
+error:
+  root elements are not equal
+path:
+  /
+got value:
+  <a/>
+want value:
+  <b/>
+got:
+  "<a/>"
+want:
+  "<b/>"
+`,
+}, {
+	about:   "XMLEquals: invalid obtained XML",
+	checker: qt.XMLEquals,
+	got:     `<a>`,
+	args:    []interface{}{`<a/>`},
+	expectedCheckFailure: `
+error:
+  cannot parse obtained contents: XML syntax error on line 1: unexpected EOF; "<a>"
+got:
+  "<a>"
+want:
+  "<a/>"
+`,
+}, {
+	about:   "XMLEquals: multiple roots",
+	checker: qt.XMLEquals,
+	got:     `<a/><a/>`,
+	args:    []interface{}{`<a/>`},
+	expectedCheckFailure: `
+error:
+  cannot parse obtained contents: multiple root elements; "<a/><a/>"
+got:
+  "<a/><a/>"
+want:
+  "<a/>"
+`,
+}, {
+	about:   "XMLEquals: invalid expected XML",
+	checker: qt.XMLEquals,
+	got:     `<a/>`,
+	args:    []interface{}{`not xml`},
+	expectedCheckFailure: `
+error:
+  bad check: cannot parse expected contents: text outside of the root element
+`,
+	expectedNegateFailure: `
+error:
+  bad check: cannot parse expected contents: text outside of the root element
+`,
+}, {
+	about:   "XMLEquals: bad type",
+	checker: qt.XMLEquals,
+	got:     42,
+	args:    []interface{}{`<a/>`},
+	expectedCheckFailure: `
+error:
+  bad check: expected string or byte, got int
+`,
+	expectedNegateFailure: `
+error:
+  bad check: expected string or byte, got int
+`,
+}}
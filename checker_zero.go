@@ -0,0 +1,80 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"errors"
+	"reflect"
+)
+
+// IsZero is a Checker checking that the provided value is the zero value for
+// its type. Values implementing an IsZero() bool method, like time.Time, are
+// checked by calling that method. On failure, the non-zero fields of structs
+// and the non-zero elements of arrays are reported.
+//
+// For instance:
+//
+//	c.Assert(deadline, qt.IsZero)
+//	c.Assert(cfg, qt.IsZero)
+var IsZero Checker = &isZeroChecker{
+	argNames: []string{"got"},
+}
+
+type isZeroChecker struct {
+	argNames
+}
+
+// zeroer is implemented by types that define their own zero values.
+type zeroer interface {
+	IsZero() bool
+}
+
+// Check implements Checker.Check by checking that got is the zero value.
+func (c *isZeroChecker) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	if got == nil {
+		return nil
+	}
+	v := reflect.ValueOf(got)
+	if canBeNil(v.Kind()) && v.IsNil() {
+		// Do not call IsZero methods on nil pointers, which are zero anyway.
+		return nil
+	}
+	if z, ok := got.(zeroer); ok {
+		if z.IsZero() {
+			return nil
+		}
+		return errors.New("got non-zero value")
+	}
+	if v.IsZero() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		var fields []string
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Field(i).IsZero() {
+				fields = append(fields, v.Type().Field(i).Name)
+			}
+		}
+		note("non-zero fields", fields)
+	case reflect.Array:
+		var indexes []int
+		for i := 0; i < v.Len(); i++ {
+			if !v.Index(i).IsZero() {
+				indexes = append(indexes, i)
+			}
+		}
+		note("non-zero indexes", indexes)
+	}
+	return errors.New("got non-zero value")
+}
+
+// IsNotZero is a Checker checking that the provided value is not the zero
+// value for its type. IsNotZero is the equivalent of qt.Not(qt.IsZero).
+//
+// For instance:
+//
+//	c.Assert(id, qt.IsNotZero)
+var IsNotZero Checker = &notChecker{
+	Checker: IsZero,
+}
@@ -0,0 +1,198 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest_test
+
+import (
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func init() {
+	checkerTests = append(checkerTests, zeroCheckerTests...)
+}
+
+type zeroConfig struct {
+	Name    string
+	Port    int
+	Tags    []string
+	timeout time.Duration
+}
+
+// customZero is zero when its value is negative.
+type customZero struct {
+	value int
+}
+
+func (z customZero) IsZero() bool {
+	return z.value < 0
+}
+
+var zeroCheckerTests = []struct {
+	about                 string
+	checker               qt.Checker
+	got                   interface{}
+	args                  []interface{}
+	verbose               bool
+	expectedCheckFailure  string
+	expectedNegateFailure string
+}{{
+	about:   "IsZero: nil",
+	checker: qt.IsZero,
+	got:     nil,
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  nil
+`,
+}, {
+	about:   "IsZero: zero struct",
+	checker: qt.IsZero,
+	got:     zeroConfig{},
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  quicktest_test.zeroConfig{}
+`,
+}, {
+	about:   "IsZero: non-zero struct",
+	checker: qt.IsZero,
+	got:     zeroConfig{Name: "server", timeout: time.Second},
+	expectedCheckFailure: `
+error:
+  got non-zero value
+non-zero fields:
+  []string{"Name", "timeout"}
+got:
+  quicktest_test.zeroConfig{
+      Name:    "server",
+      Port:    0,
+      Tags:    nil,
+      timeout: 1000000000,
+  }
+`,
+}, {
+	about:   "IsZero: zero array",
+	checker: qt.IsZero,
+	got:     [3]int{},
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  [3]int{0, 0, 0}
+`,
+}, {
+	about:   "IsZero: non-zero array",
+	checker: qt.IsZero,
+	got:     [4]string{"a", "", "", "b"},
+	expectedCheckFailure: `
+error:
+  got non-zero value
+non-zero indexes:
+  []int{0, 3}
+got:
+  [4]string{"a", "", "", "b"}
+`,
+}, {
+	about:   "IsZero: zero time",
+	checker: qt.IsZero,
+	got:     time.Time{},
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  s"0001-01-01 00:00:00 +0000 UTC"
+`,
+}, {
+	about:   "IsZero: non-zero time",
+	checker: qt.IsZero,
+	got:     goTime,
+	expectedCheckFailure: `
+error:
+  got non-zero value
+got:
+  s"2012-03-28 00:00:00 +0000 UTC"
+`,
+}, {
+	about:   "IsZero: IsZero method",
+	checker: qt.IsZero,
+	got:     customZero{value: -1},
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  quicktest_test.customZero{value:-1}
+`,
+}, {
+	about:   "IsZero: IsZero method returning false",
+	checker: qt.IsZero,
+	got:     customZero{},
+	expectedCheckFailure: `
+error:
+  got non-zero value
+got:
+  quicktest_test.customZero{}
+`,
+}, {
+	about:   "IsZero: nil pointer with IsZero method",
+	checker: qt.IsZero,
+	got:     (*time.Time)(nil),
+	expectedNegateFailure: `
+error:
+  got zero value but want non-zero
+got:
+  s<nil>
+`,
+}, {
+	about:   "IsZero: empty slice",
+	checker: qt.IsZero,
+	got:     []int{},
+	expectedCheckFailure: `
+error:
+  got non-zero value
+got:
+  []int{}
+`,
+}, {
+	about:   "IsZero: non-zero int",
+	checker: qt.IsZero,
+	got:     42,
+	expectedCheckFailure: `
+error:
+  got non-zero value
+got:
+  int(42)
+`,
+}, {
+	about:   "IsNotZero: success",
+	checker: qt.IsNotZero,
+	got:     "bad wolf",
+	expectedNegateFailure: `
+error:
+  got non-zero value
+got:
+  "bad wolf"
+`,
+}, {
+	about:   "IsNotZero: failure",
+	checker: qt.IsNotZero,
+	got:     "",
+	expectedCheckFailure: `
+error:
+  got zero value but want non-zero
+got:
+  ""
+`,
+}, {
+	about:   "IsNotZero: zero struct",
+	checker: qt.IsNotZero,
+	got:     zeroConfig{},
+	expectedCheckFailure: `
+error:
+  got zero value but want non-zero
+got:
+  quicktest_test.zeroConfig{}
+`,
+}}
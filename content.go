@@ -0,0 +1,235 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+package quicktest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// sortSlices returns a cmp option sorting all the slices in the compared
+// values, so that slices with the same elements in different orders are
+// considered equal.
+//
+// Elements are sorted by a structural representation computed once per
+// element. The representation includes dynamic types, so that values of
+// different types never collide, and it does not depend on the order of
+// the elements in nested slices and maps, so that elements which are equal
+// once sorted are also adjacent in the sorted slice.
+func sortSlices() cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		// Do not sort again the values produced by the transformer itself.
+		if _, ok := p.Last().(cmp.TypeAssertion); ok {
+			if t, ok := p.Index(-2).(cmp.Transform); ok && t.Name() == "SortSlices" {
+				return false
+			}
+		}
+		vx, vy := p.Last().Values()
+		return vx.Kind() == reflect.Slice && vy.Kind() == reflect.Slice && (vx.Len() > 1 || vy.Len() > 1)
+	}, cmp.Transformer("SortSlices", func(x interface{}) interface{} {
+		src := reflect.ValueOf(x)
+		order := make([]int, src.Len())
+		for i := range order {
+			order[i] = i
+		}
+		if less := orderedLess(src); less != nil {
+			// Basic values are sorted in their natural order, so that the
+			// diff is easier to read.
+			sort.SliceStable(order, func(i, j int) bool {
+				return less(order[i], order[j])
+			})
+		} else {
+			keys := make([]string, src.Len())
+			for i := range keys {
+				keys[i] = contentKey(src.Index(i))
+			}
+			sort.SliceStable(order, func(i, j int) bool {
+				return keys[order[i]] < keys[order[j]]
+			})
+		}
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i, j := range order {
+			dst.Index(i).Set(src.Index(j))
+		}
+		return dst.Interface()
+	}))
+}
+
+// orderedLess returns a function reporting whether the element at index i of
+// the given slice is less than the element at index j, or nil if the elements
+// do not have an ordered basic kind.
+func orderedLess(v reflect.Value) func(i, j int) bool {
+	switch v.Type().Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(i, j int) bool { return v.Index(i).Int() < v.Index(j).Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(i, j int) bool { return v.Index(i).Uint() < v.Index(j).Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(i, j int) bool { return v.Index(i).Float() < v.Index(j).Float() }
+	case reflect.String:
+		return func(i, j int) bool { return v.Index(i).String() < v.Index(j).String() }
+	}
+	return nil
+}
+
+// contentKey returns the structural representation of the given value used
+// to sort slice elements.
+func contentKey(v reflect.Value) string {
+	e := &contentEncoder{
+		visiting: make(map[visit]bool),
+	}
+	e.encode(v)
+	return e.buf.String()
+}
+
+// contentEncoder encodes values for sorting them.
+type contentEncoder struct {
+	buf bytes.Buffer
+	// visiting holds the references being encoded, used to detect cycles.
+	visiting map[visit]bool
+}
+
+// visit identifies a reference value.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func (e *contentEncoder) encode(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		e.buf.WriteString("nil")
+	case reflect.Bool:
+		e.buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		e.buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprintf(&e.buf, "%g", v.Complex())
+	case reflect.String:
+		e.buf.WriteString(strconv.Quote(v.String()))
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		fmt.Fprintf(&e.buf, "%#x", v.Pointer())
+	case reflect.Interface:
+		if v.IsNil() {
+			e.buf.WriteString("nil")
+			return
+		}
+		v = v.Elem()
+		e.buf.WriteString(v.Type().String())
+		e.buf.WriteByte('(')
+		e.encode(v)
+		e.buf.WriteByte(')')
+	case reflect.Ptr:
+		if v.IsNil() {
+			e.buf.WriteString("nil")
+			return
+		}
+		if !e.enter(v) {
+			return
+		}
+		e.buf.WriteByte('&')
+		e.encode(v.Elem())
+		e.leave(v)
+	case reflect.Array:
+		e.buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				e.buf.WriteByte(',')
+			}
+			e.encode(v.Index(i))
+		}
+		e.buf.WriteByte(']')
+	case reflect.Slice:
+		if v.IsNil() {
+			e.buf.WriteString("nil")
+			return
+		}
+		if !e.enter(v) {
+			return
+		}
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = e.sub(v.Index(i))
+		}
+		e.writeSorted('[', elems, ']')
+		e.leave(v)
+	case reflect.Map:
+		if v.IsNil() {
+			e.buf.WriteString("nil")
+			return
+		}
+		if !e.enter(v) {
+			return
+		}
+		pairs := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k := e.sub(iter.Key())
+			pairs = append(pairs, strconv.Itoa(len(k))+":"+k+e.sub(iter.Value()))
+		}
+		e.writeSorted('{', pairs, '}')
+		e.leave(v)
+	case reflect.Struct:
+		e.buf.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				e.buf.WriteByte(',')
+			}
+			e.encode(v.Field(i))
+		}
+		e.buf.WriteByte('}')
+	}
+}
+
+// sub returns the encoding of the given value, sharing the references being
+// visited with e.
+func (e *contentEncoder) sub(v reflect.Value) string {
+	sub := &contentEncoder{
+		visiting: e.visiting,
+	}
+	sub.encode(v)
+	return sub.buf.String()
+}
+
+// writeSorted writes the given encodings in sorted order, so that the result
+// does not depend on their original order. Each encoding is prefixed with its
+// length to keep the result unambiguous.
+func (e *contentEncoder) writeSorted(start byte, encodings []string, end byte) {
+	sort.Strings(encodings)
+	e.buf.WriteByte(start)
+	for i, s := range encodings {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		e.buf.WriteString(strconv.Itoa(len(s)))
+		e.buf.WriteByte(':')
+		e.buf.WriteString(s)
+	}
+	e.buf.WriteByte(end)
+}
+
+// enter records that the given reference value is being encoded. If it is
+// already being encoded, a cycle marker is written and false is returned.
+func (e *contentEncoder) enter(v reflect.Value) bool {
+	k := visit{v.Pointer(), v.Type()}
+	if e.visiting[k] {
+		e.buf.WriteString("<cycle>")
+		return false
+	}
+	e.visiting[k] = true
+	return true
+}
+
+// leave records that the given reference value is no longer being encoded.
+func (e *contentEncoder) leave(v reflect.Value) {
+	delete(e.visiting, visit{v.Pointer(), v.Type()})
+}
@@ -46,6 +46,12 @@ For really short tests, the extra line for instantiating *qt.C can be avoided:
 	qt.Assert(t, someValue, qt.Equals, wantValue)
 	qt.Check(t, someValue, qt.Equals, wantValue)
 
+On Go >= 1.21, the github.com/frankban/quicktest/qtgeneric package provides a
+type parameterised companion API, in which checkers are bound to the type of
+the checked value, so that type mismatches become compile errors:
+
+	qtgeneric.Assert(t, someValue, qtgeneric.Equals(wantValue))
+
 The library provides some base checkers like Equals, DeepEquals, Matches,
 ErrorMatches, IsNil and others. More can be added by implementing the Checker
 interface. Below, we list the checkers implemented by the package in alphabetical
@@ -76,6 +82,86 @@ For example:
 
 See also All and Contains.
 
+# ApproxEquals
+
+ApproxEquals returns a Checker checking that two numbers are equal within the
+given absolute tolerance. Floating point, complex and integer values are
+supported, as well as slices, arrays and maps of them, in which case the
+elements are compared pairwise. On failure, the delta between the values, the
+tolerance and, for containers, the mismatched element are reported.
+
+NaN values are never equal and infinite values are equal when they have the
+same sign: this can be changed by passing the EquateNaNs and RejectInfs
+options.
+
+For instance:
+
+	c.Assert(math.Pi, qt.ApproxEquals(0.01), 3.14)
+	c.Assert(results, qt.ApproxEquals(1e-9), []float64{0.3, 1})
+	c.Assert(math.NaN(), qt.ApproxEquals(0, qt.EquateNaNs), math.NaN())
+
+See also RelEquals and ULPEquals.
+
+# AtLeast
+
+AtLeast checks that the provided value is greater than or equal to the given
+bound. See GreaterThan for the supported values.
+
+For instance:
+
+	c.Assert(retries, qt.AtLeast, 3)
+
+# AtMost
+
+AtMost checks that the provided value is less than or equal to the given
+bound. See GreaterThan for the supported values.
+
+For instance:
+
+	c.Assert(name, qt.AtMost, "m")
+
+# Between
+
+Between checks that the provided value is between the given min and max
+values, inclusive. See GreaterThan for the supported values.
+
+For instance:
+
+	c.Assert(percent, qt.Between, 0, 100)
+	c.Assert(elapsed, qt.Between, time.Second, 2*time.Second)
+
+# Bind
+
+Bind returns a Checker calling the given checker with the given arguments. The
+resulting checker does not accept arguments, which is useful when providing
+checkers to other checkers, like MatchesGroups.
+
+For instance:
+
+	c.Assert(answer, qt.Bind(qt.Equals, 42))
+
+# BodyEquals
+
+BodyEquals checks that the body of an HTTP response, provided as an
+*http.Response or as an *httptest.ResponseRecorder, is equal to the given
+string or byte slice. The body is buffered, so that it can still be read after
+the check. On failure, the request method and URL, when available, and the
+response status, headers and truncated body are reported.
+
+For instance:
+
+	c.Assert(rec, qt.BodyEquals, "hello world\n")
+
+# BodyJSONEquals
+
+BodyJSONEquals checks that the body of an HTTP response contains JSON which is
+equivalent to the given value, as done by qt.JSONEquals. The response is
+provided and reported as with qt.BodyEquals.
+
+For instance:
+
+	c.Assert(resp, qt.BodyJSONEquals, map[string]interface{}{"id": 47.0})
+
 # CmpEquals
 
 CmpEquals checks equality of two arbitrary values according to the provided
@@ -97,18 +183,32 @@ CodecEquals returns a checker that checks for codec value equivalence.
 	    opts ...cmp.Option,
 	) Checker
 
-It expects two arguments: a byte slice or a string containing some
-codec-marshaled data, and a Go value.
+It expects two arguments: the obtained value and the wanted value. A byte
+slice, a string or an Encoded value is taken to contain some codec-marshaled
+data. Any other value is a Go value, which is first marshaled using marshal.
 
 It uses unmarshal to unmarshal the data into an interface{} value.
-It marshals the Go value using marshal, then unmarshals the result into
-an interface{} value.
+Go values are marshaled using marshal, then the result is unmarshaled into
+an interface{} value. The wanted value is always marshaled, unless it is of
+type Encoded.
 
 It then checks that the two interface{} values are deep-equal to one another,
 using CmpEquals(opts) to perform the check.
 
 See JSONEquals for an example of this in use.
 
+# Consistently
+
+Consistently returns a Checker that repeatedly calls the provided function,
+which must be of type func() T, and checks the returned value using the given
+checker for the given duration. The check fails as soon as one of the attempts
+fails. The given interval is waited between attempts. The checker arguments,
+if any, must be provided after the function.
+
+For instance:
+
+	c.Assert(func() int { return len(queue) }, qt.Consistently(qt.Equals, time.Second, 10*time.Millisecond), 0)
+
 # Contains
 
 Contains checks that a map, slice, array or string contains a value. It's the
@@ -121,6 +221,51 @@ For example:
 	c.Assert("hello world", qt.Contains, "world")
 	c.Assert([]int{3,5,7,99}, qt.Contains, 7)
 
+# ContainsAll
+
+ContainsAll checks that all the elements of the given container are also
+elements of the provided container. Containers can be slices, arrays or maps,
+in which case their values are considered. Elements are compared using
+DeepEquals. On failure, the missing elements are reported.
+
+For instance:
+
+	c.Assert(permissions, qt.ContainsAll, []string{"read", "write"})
+
+ContainsAllWith can be used to compare elements with a different checker.
+
+# ContainsExactly
+
+ContainsExactly checks that the provided and the given containers have the same
+elements, regardless of their order. Repeated elements must appear the same
+number of times in both containers. On failure, the missing and unexpected
+elements are reported.
+
+For instance:
+
+	c.Assert(ids, qt.ContainsExactly, []int{3, 1, 2, 1})
+
+ContainsExactlyWith can be used to compare elements with a different checker.
+
+# ContainsFold
+
+ContainsFold checks that the provided string contains the given substring under
+Unicode case-folding. See HasPrefix for the supported values.
+
+For instance:
+
+	c.Assert(output, qt.ContainsFold, "warning")
+
+# ContainsMatch
+
+ContainsMatch checks that the provided string, error or fmt.Stringer contains a
+match for the provided regular expression pattern. Unlike Matches, the pattern
+is not anchored.
+
+For instance:
+
+	c.Assert(output, qt.ContainsMatch, `request id: \w+`)
+
 # ContentEquals
 
 ContentEquals is is like DeepEquals but any slices in the compared values will be sorted before being compared.
@@ -140,6 +285,50 @@ Example call:
 
 	c.Assert(got, qt.DeepEquals, []int{42, 47})
 
+# DirEquals
+
+DirEquals returns a Checker checking that the directory at the provided path
+includes the same regular files, with the same contents, and the same symbolic
+links, with the same targets, as the wanted tree. The wanted tree can be
+provided as a *txtar.Archive, from github.com/rogpeppe/go-internal/txtar, or
+as the path of another directory. File names in archives can include the
+attributes understood by c.MkdirFrom, so that an archive used to populate a
+directory can also be used to check it. On failure, missing, unexpected and
+differing files are reported, with line diffs for text files.
+
+The qt.IgnoreFiles option ignores the files matching the given glob patterns,
+and the qt.CompareFileModes option also compares the permission bits of the
+files. With archives, only the files with a mode attribute are compared.
+
+For instance:
+
+	c.Assert(dir, qt.DirEquals(), txtar.Parse([]byte(`
+	-- go.mod --
+	module example.com/hello
+	-- hello.go --
+	package hello
+	`)))
+	c.Assert(dir, qt.DirEquals(qt.IgnoreFiles("*.log"), qt.CompareFileModes()), "testdata/want")
+
+# DoesNotPanic
+
+DoesNotPanic checks that the provided function does not panic. On failure, the
+panic value and the stack of the panicking goroutine are reported.
+
+For instance:
+
+	c.Assert(func() { handler.ServeHTTP(w, req) }, qt.DoesNotPanic)
+
+# EqualFold
+
+EqualFold checks that the provided string is equal to the given one under
+Unicode case-folding. See HasPrefix for the supported values. On failure, the
+longest common prefix is reported.
+
+For instance:
+
+	c.Assert(resp.Header.Get("Content-Type"), qt.EqualFold, "application/json")
+
 # Equals
 
 Equals checks that two values are equal, as compared with Go's == operator.
@@ -158,6 +347,8 @@ Use the IsNil checker below for this kind of nil check.
 
 ErrorAs checks that the error is or wraps a specific error type. If so, it
 assigns it to the provided pointer. This is analogous to calling errors.As.
+On failure, the chain of wrapped errors is reported, with the type and message
+of each error.
 
 For instance:
 
@@ -170,15 +361,41 @@ For instance:
 	    c.Assert(pathError.Path, Equals, "some_path")
 	}
 
+# ErrorAsMatching
+
+ErrorAsMatching returns a Checker checking that the error is or wraps a
+specific error type, like ErrorAs, and that the extracted error satisfies the
+given checker. The checker arguments, if any, must be provided after the error.
+On failure, the notes added by the given checker are reported, prefixed with
+the wanted error type.
+
+For instance:
+
+	c.Assert(err, qt.ErrorAsMatching(new(*os.PathError), qt.StructMatches(qt.Fields{
+		"Op": qt.Bind(qt.Equals, "open"),
+	})))
+
 # ErrorIs
 
 ErrorIs checks that the error is or wraps a specific error value. This is
-analogous to calling errors.Is.
+analogous to calling errors.Is. On failure, the chain of wrapped errors is
+reported, as with ErrorAs.
 
 For instance:
 
 	c.Assert(err, qt.ErrorIs, os.ErrNotExist)
 
+# ErrorIsAll
+
+ErrorIsAll returns a Checker checking that the error is or wraps all the given
+error values. The whole error tree is walked, including the errors wrapped with
+Unwrap() []error methods, like the ones returned by errors.Join. On failure,
+the missing errors are reported.
+
+For instance:
+
+	c.Assert(err, qt.ErrorIsAll(ErrMissingName, ErrInvalidEmail))
+
 # ErrorMatches
 
 ErrorMatches checks that the provided value is an error whose message matches
@@ -188,6 +405,106 @@ For instance:
 
 	c.Assert(err, qt.ErrorMatches, `bad wolf .*`)
 
+# ErrorsExactly
+
+ErrorsExactly returns a Checker checking that the error tree contains exactly
+the given error values and nothing else: each wanted error must be found in the
+tree, and each leaf error which is not wrapped by a wanted error is unexpected.
+On failure, the missing and unexpected errors are reported separately.
+
+For instance:
+
+	c.Assert(err, qt.ErrorsExactly(ErrMissingName, ErrInvalidEmail))
+
+# Eventually
+
+Eventually returns a Checker that repeatedly calls the provided function,
+which must be of type func() T, and checks the returned value using the given
+checker, until the check succeeds or the given timeout expires. The given
+interval is waited between attempts. The checker arguments, if any, must be
+provided after the function. On failure, the number of attempts, the elapsed
+time and the value and notes from the last attempt are reported.
+
+For instance:
+
+	c.Assert(func() int { return counter.Load() }, qt.Eventually(qt.Equals, time.Second, 10*time.Millisecond), 42)
+	c.Assert(srv.Ready, qt.Eventually(qt.IsTrue, 5*time.Second, 100*time.Millisecond))
+
+# FileContents
+
+FileContents returns a Checker checking that the contents of the file at the
+provided path satisfy the given checker. The contents are passed to the checker
+as a string, and the checker arguments, if any, must be provided after the
+path. On failure, the path is included in the report.
+
+For instance:
+
+	c.Assert(filepath.Join(dir, "out.txt"), qt.FileContents(qt.Equals), "hello\n")
+	c.Assert(filepath.Join(dir, "out.json"), qt.FileContents(qt.JSONEquals), want)
+
+# FileExists
+
+FileExists checks that a file exists at the provided path. Symbolic links are
+not followed, so a link to a missing file exists.
+
+For instance:
+
+	c.Assert(filepath.Join(dir, "config.yaml"), qt.FileExists)
+
+# FileMode
+
+FileMode checks that the permission bits of the file at the provided path are
+equal to the given ones, provided as an os.FileMode or as an integer.
+
+For instance:
+
+	c.Assert(filepath.Join(dir, "id_rsa"), qt.FileMode, 0600)
+
+# GoldenEquals
+
+GoldenEquals returns a Checker checking that the provided string or byte slice
+is equal to the contents of the golden file at the given path. When the tests
+are run with the -qt.update flag, or with the QT_UPDATE environment variable
+set to "1", the golden file is written with the provided contents instead. On
+failure, a line diff is reported for text contents, and a diff of the
+hexadecimal dumps for binary contents.
+
+For instance:
+
+	c.Assert(output, qt.GoldenEquals("testdata/output.golden"))
+
+# GreaterThan
+
+GreaterThan checks that the provided value is greater than the given bound.
+Values with ordered underlying kinds (integers, floating point numbers and
+strings) and time.Time values are supported. Both values must have the same
+kind. On failure, the difference between the value and the bound is reported.
+
+For instance:
+
+	c.Assert(len(results), qt.GreaterThan, 5)
+	c.Assert(elapsed, qt.GreaterThan, time.Second)
+	c.Assert(deadline, qt.GreaterThan, time.Now())
+
+# HasKey
+
+HasKey checks that the provided map has the given key. On failure, the sorted
+list of the keys present in the map is reported.
+
+For instance:
+
+	c.Assert(headers, qt.HasKey, "Content-Type")
+
+# HasKeys
+
+HasKeys returns a Checker checking that the provided map has all the given
+keys. On failure, the missing keys and the sorted list of the keys present in
+the map are reported.
+
+For instance:
+
+	c.Assert(config, qt.HasKeys("host", "port"))
+
 # HasLen
 
 HasLen checks that the provided value has the given length.
@@ -197,6 +514,37 @@ For instance:
 	c.Assert([]int{42, 47}, qt.HasLen, 2)
 	c.Assert(myMap, qt.HasLen, 42)
 
+# HasPrefix
+
+HasPrefix checks that the provided string starts with the given prefix. The
+provided value can also be a []byte, an error or a fmt.Stringer. On failure,
+the longest common prefix is reported.
+
+For instance:
+
+	c.Assert(err, qt.HasPrefix, "cannot open file: ")
+
+# HasSuffix
+
+HasSuffix checks that the provided string ends with the given suffix. See
+HasPrefix for the supported values. On failure, the longest common suffix is
+reported.
+
+For instance:
+
+	c.Assert(path, qt.HasSuffix, ".go")
+
+# HeaderMatches
+
+HeaderMatches returns a Checker checking that the value of the given header in
+an HTTP response satisfies the given checker. Multiple values for the header
+are joined with ", ", and the check fails if the header is not present. The
+response is provided and reported as with qt.BodyEquals.
+
+For instance:
+
+	c.Assert(resp, qt.HeaderMatches("Content-Type", qt.Equals), "application/json")
+
 # Implements
 
 Implements checks that the provided value implements an interface. The
@@ -207,6 +555,60 @@ For instance:
 	var rc io.ReadCloser
 	c.Assert(myReader, qt.Implements, &rc)
 
+# InlineEquals
+
+InlineEquals checks that the snapshot of the provided value is equal to the
+given string. Strings are used as is, and other values are formatted as they
+are in failure reports: see qt.Snapshot. When the tests are run with the
+-qt.update flag, or with the QT_UPDATE environment variable set to "1", the
+string literal passed to the checker is rewritten in the test source with the
+snapshot of the provided value instead. For this to work, the snapshot must be
+provided as a string literal directly in the call to Assert or Check.
+
+For instance:
+
+	c.Assert(strings.Fields(" a b "), qt.InlineEquals, `[]string{"a", "b"}`)
+
+# IsChanEmpty
+
+IsChanEmpty checks that the provided channel has no value ready to be received.
+A closed channel is empty. Values buffered in the channel are reported without
+being received. For unbuffered channels, a value sent by a blocked sender is
+received and reported.
+
+For instance:
+
+	c.Assert(errs, qt.IsChanEmpty)
+
+# IsClosed
+
+IsClosed checks that the provided channel is closed. Values buffered in the
+channel are reported without being received. For unbuffered channels, a value
+sent by a blocked sender is received and reported.
+
+For instance:
+
+	c.Assert(done, qt.IsClosed)
+
+# IsDir
+
+IsDir checks that the provided path is a directory.
+
+For instance:
+
+	c.Assert(filepath.Join(dir, "cache"), qt.IsDir)
+
+# IsDisjoint
+
+IsDisjoint checks that the provided and the given containers have no elements
+in common. On failure, the common elements are reported.
+
+For instance:
+
+	c.Assert(allowed, qt.IsDisjoint, denied)
+
+IsDisjointWith can be used to compare elements with a different checker.
+
 # IsFalse
 
 IsFalse checks that the provided value is false.
@@ -244,6 +646,30 @@ For instance:
 
 	c.Assert(got, qt.IsNotNil)
 
+# IsNotZero
+
+IsNotZero is a Checker checking that the provided value is not the zero value
+for its type. IsNotZero is the equivalent of qt.Not(qt.IsZero)
+
+For instance:
+
+	c.Assert(id, qt.IsNotZero)
+
+# IsSubsetOf
+
+IsSubsetOf checks that all the elements of the provided container are also
+elements of the given container. On failure, the unexpected elements are
+reported.
+
+For instance:
+
+	c.Assert([]string{"a", "b"}, qt.IsSubsetOf, []string{"c", "b", "a"})
+
+IsSubsetOfWith can be used to compare elements with a different checker, for
+instance:
+
+	c.Assert([]float64{1.01}, qt.IsSubsetOfWith(qt.ApproxEquals(0.1)), []float64{1, 2})
+
 # IsTrue
 
 IsTrue checks that the provided value is true.
@@ -254,6 +680,32 @@ For instance:
 	c.Assert(true, qt.IsTrue)
 	c.Assert(myBoolean(false), qt.IsTrue)
 
+# IsZero
+
+IsZero is a Checker checking that the provided value is the zero value for its
+type. Values implementing an IsZero() bool method, like time.Time, are checked
+by calling that method. On failure, the non-zero fields of structs and the
+non-zero elements of arrays are reported.
+
+For instance:
+
+	c.Assert(deadline, qt.IsZero)
+
+# JSONContains
+
+JSONContains checks that a byte slice or string contains JSON which is a
+superset of the JSON encoding of the given Go value. JSON objects are compared
+recursively, ignoring the keys which are not present in the wanted value.
+Arrays must have the same length, and their elements are compared recursively.
+On failure, each mismatch is reported with its JSON path.
+
+For instance:
+
+	c.Assert(body, qt.JSONContains, map[string]interface{}{
+		"name":  "bob",
+		"roles": []string{"admin"},
+	})
+
 # JSONEquals
 
 JSONEquals checks whether a byte slice or string is JSON-equivalent to a Go
@@ -265,6 +717,52 @@ required, use CodecEquals directly.
 For instance:
 
 	c.Assert(`{"First": 47.11}`, qt.JSONEquals, &MyStruct{First: 47.11})
+	c.Assert(&MyStruct{First: 47.11}, qt.JSONEquals, qt.Encoded(`{"First": 47.11}`))
+
+JSON numbers are decoded as float64 values, so large integers may be rounded.
+To compare them exactly, use UnmarshalJSONNumbers with CodecEquals:
+
+	c.Assert(got, qt.CodecEquals(json.Marshal, qt.UnmarshalJSONNumbers), want)
+
+# JSONPathMatches
+
+JSONPathMatches returns a Checker checking that the value found at the given
+path in a byte slice or string containing JSON satisfies the given checker.
+The path starts with "$", followed by object keys, like ".name" or
+`["first name"]`, and array indexes, like "[0]". JSON numbers are decoded as
+float64 values.
+
+For instance:
+
+	c.Assert(body, qt.JSONPathMatches("$.items[0].id", qt.Equals), 47.0)
+
+# LessThan
+
+LessThan checks that the provided value is less than the given bound. See
+GreaterThan for the supported values.
+
+For instance:
+
+	c.Assert(elapsed, qt.LessThan, 100*time.Millisecond)
+
+# LinksTo
+
+LinksTo checks that the provided path is a symbolic link whose target, as
+stored in the link, is equal to the given string.
+
+For instance:
+
+	c.Assert(filepath.Join(dir, "current"), qt.LinksTo, "releases/v2")
+
+# MapContains
+
+MapContains returns a Checker checking that the provided map has the given key,
+and that the value stored under that key satisfies the given checker. The
+checker arguments, if any, must be provided after the map.
+
+For instance:
+
+	c.Assert(counts, qt.MapContains("errors", qt.Equals), 0)
 
 # Matches
 
@@ -276,6 +774,20 @@ For instance:
 	c.Assert("these are the voyages", qt.Matches, `these are .*`)
 	c.Assert(net.ParseIP("1.2.3.4"), qt.Matches, `1.*`)
 
+# MatchesGroups
+
+MatchesGroups returns a Checker checking that the provided string, error or
+fmt.Stringer matches the given regular expression pattern, and that the named
+capture groups satisfy the corresponding checkers. Checkers must not require
+arguments: use Bind to provide them. On failure, all the captured groups are
+reported.
+
+For instance:
+
+	c.Assert(err, qt.MatchesGroups(`user (?P<id>\d+) not found`, map[string]qt.Checker{
+		"id": qt.Bind(qt.Equals, "42"),
+	}))
+
 # Not
 
 Not returns a Checker negating the given Checker.
@@ -294,6 +806,47 @@ For instance:
 
 	c.Assert(func() {panic("bad wolf ...")}, qt.PanicMatches, `bad wolf .*`)
 
+# Panics
+
+Panics returns a Checker checking that the provided function panics, and that
+the recovered value satisfies the given checker. Unlike PanicMatches, the
+checker receives the raw recovered value. The checker arguments, if any, must
+be provided after the function.
+
+For instance:
+
+	c.Assert(func() { panic(ErrInvalid) }, qt.Panics(qt.ErrorIs), ErrInvalid)
+
+# Receives
+
+Receives returns a Checker receiving a value from the provided channel and
+checking it using the given checker. The checker arguments, if any, must be
+provided after the channel, followed by the time.Duration to wait for a value
+before failing.
+
+For instance:
+
+	c.Assert(ch, qt.Receives(qt.Equals), 42, time.Second)
+
+# ReceivesWithin
+
+ReceivesWithin is like Receives, except that the time to wait for a value is
+provided when creating the checker.
+
+For instance:
+
+	c.Assert(ch, qt.ReceivesWithin(qt.Equals, time.Second), 42)
+
+# RelEquals
+
+RelEquals returns a Checker checking that two numbers are equal within the
+given relative tolerance. It supports the same values and options as
+ApproxEquals.
+
+For instance:
+
+	c.Assert(1.001e9, qt.RelEquals(0.01), 1e9)
+
 # Satisfies
 
 Satisfies checks that the provided value, when used as argument of the provided
@@ -308,11 +861,60 @@ For instance:
 	// Check that a floating point number is a not-a-number.
 	c.Assert(f, qt.Satisfies, math.IsNaN)
 
+# StatusEquals
+
+StatusEquals checks that the status code of an HTTP response is equal to the
+given one. The response is provided and reported as with qt.BodyEquals.
+
+For instance:
+
+	c.Assert(rec, qt.StatusEquals, http.StatusNotFound)
+
+# StructMatches
+
+StructMatches returns a Checker checking that the fields of the provided
+struct, or pointer to struct, satisfy the given checkers. Fields are identified
+by dot separated paths, like "Owner.Name", and fields which are not included
+are ignored. Pointers and embedded structs are followed when descending into
+nested fields. On failure, all the fields which do not satisfy their checkers
+are reported.
+
+For instance:
+
+	c.Assert(user, qt.StructMatches(qt.Fields{
+		"Name":      qt.Bind(qt.Equals, "bob"),
+		"CreatedAt": qt.IsNotZero,
+	}))
+
+# ULPEquals
+
+ULPEquals returns a Checker checking that two floating point numbers are at
+most the given number of units in the last place (ULPs) apart. It supports the
+same values and options as ApproxEquals.
+
+For instance:
+
+	c.Assert(math.Sqrt(2)*math.Sqrt(2), qt.ULPEquals(1), 2.0)
+
+# XMLEquals
+
+XMLEquals checks that a byte slice or string contains XML which is equivalent
+to the given XML document, provided as a byte slice, a string or a Go value to
+be marshaled with encoding/xml. Both documents are parsed into canonical
+element trees, so that the order of attributes, namespace prefixes, comments
+and insignificant whitespace are ignored. On failure, each difference is
+reported with an XPath-like location.
+
+For instance:
+
+	c.Assert(feed, qt.XMLEquals, `<feed xmlns="http://www.w3.org/2005/Atom"><title>news</title></feed>`)
+
 # Deferred Execution
 
 The testing.TB.Cleanup helper provides the ability to defer the execution of
 functions that will be run when the test completes. This is often useful for
-creating OS-level resources such as temporary directories (see c.Mkdir).
+creating OS-level resources such as temporary directories (see c.Mkdir, and
+c.MkdirFrom and c.MkdirFiles for directories populated with files).
 
 When targeting Go versions that don't have Cleanup (< 1.14), the same can be
 achieved using c.Defer. In this case, to trigger the deferred behavior, calling
@@ -334,7 +936,8 @@ automatically at the end of that subtest. For example:
 	    })
 	}
 
-The c.Patch, c.Setenv, c.Unsetenv and c.Mkdir helpers use t.Cleanup for
-cleaning up resources when available, and fall back to Defer otherwise.
+The c.Patch, c.Setenv, c.Unsetenv, c.Mkdir, c.MkdirFrom and c.MkdirFiles
+helpers use t.Cleanup for cleaning up resources when available, and fall back
+to Defer otherwise.
 */
 package quicktest
@@ -3,7 +3,7 @@ module github.com/frankban/quicktest
 require (
 	github.com/google/go-cmp v0.6.0
 	github.com/kr/pretty v0.3.1
-	github.com/rogpeppe/go-internal v1.12.0 // indirect
+	github.com/rogpeppe/go-internal v1.12.0
 )
 
 go 1.13
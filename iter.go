@@ -14,6 +14,9 @@ type containerIter interface {
 	next() bool
 	// key returns the current key as a string.
 	key() string
+	// keyValue returns the current key, which is the index for slices and
+	// arrays.
+	keyValue() reflect.Value
 	// value returns the current value.
 	value() reflect.Value
 }
@@ -53,3 +56,7 @@ func (i *sliceIter) value() reflect.Value {
 func (i *sliceIter) key() string {
 	return fmt.Sprintf("index %d", i.index)
 }
+
+func (i *sliceIter) keyValue() reflect.Value {
+	return reflect.ValueOf(i.index)
+}
@@ -24,6 +24,10 @@ func (i mapIter) key() string {
 	return fmt.Sprintf("key %#v", i.iter.Key())
 }
 
+func (i mapIter) keyValue() reflect.Value {
+	return i.iter.Key()
+}
+
 func (i mapIter) value() reflect.Value {
 	return i.iter.Value()
 }
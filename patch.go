@@ -3,9 +3,18 @@
 package quicktest
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rogpeppe/go-internal/txtar"
 )
 
 // Patch sets a variable to a temporary value for the duration of the test.
@@ -62,6 +71,192 @@ func (c *C) Mkdir() string {
 	return name
 }
 
+// MkdirFrom makes a temporary directory populated with the files in the given
+// txtar archive, as parsed by github.com/rogpeppe/go-internal/txtar, and
+// returns its name. The archive comment is ignored.
+//
+// File names are slash-separated paths relative to the directory, and parent
+// directories are created as needed. A name can be followed by attributes,
+// separated by spaces:
+//
+//   - "mode=0755" sets the permission bits of the file or directory, once all
+//     the files are created;
+//   - "-> target" makes the file a symbolic link to the given target, in which
+//     case the file contents must be empty.
+//
+// A name ending with a slash makes an empty directory. The same syntax is
+// understood by DirEquals, so that an archive used to populate a directory can
+// also be used to check it. For instance:
+//
+//	dir := c.MkdirFrom(`
+//	-- go.mod --
+//	module example.com/hello
+//	-- bin/run.sh mode=0755 --
+//	#!/bin/sh
+//	-- current -> bin --
+//	-- cache/ --
+//	`)
+//
+// At the end of the test (see "Deferred execution" in the package docs), the
+// directory and its contents are removed.
+func (c *C) MkdirFrom(archive string) string {
+	c.TB.Helper()
+	files := make(map[string]string)
+	var names []string
+	for _, f := range txtar.Parse([]byte(archive)).Files {
+		if _, ok := files[f.Name]; !ok {
+			names = append(names, f.Name)
+		}
+		files[f.Name] = string(f.Data)
+	}
+	return c.mkdirFiles(names, files)
+}
+
+// MkdirFiles makes a temporary directory populated with the given files, and
+// returns its name. Keys are file names, with the same syntax used by
+// MkdirFrom, and values are file contents. For instance:
+//
+//	dir := c.MkdirFiles(map[string]string{
+//		"go.mod":               "module example.com/hello\n",
+//		"bin/run.sh mode=0755": "#!/bin/sh\n",
+//		"current -> bin":       "",
+//	})
+//
+// At the end of the test (see "Deferred execution" in the package docs), the
+// directory and its contents are removed.
+func (c *C) MkdirFiles(files map[string]string) string {
+	c.TB.Helper()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return c.mkdirFiles(names, files)
+}
+
+// mkdirFiles makes a temporary directory populated with the given files,
+// created in the order of the given names.
+func (c *C) mkdirFiles(names []string, files map[string]string) string {
+	c.TB.Helper()
+	dir := c.Mkdir()
+	var withMode []*fixture
+	for _, name := range names {
+		f, err := parseFixture(name, files[name])
+		if err == nil {
+			err = f.write(dir, files[name])
+		}
+		c.Assert(err, IsNil, Commentf("file %q", name))
+		if err == nil && f.hasMode {
+			withMode = append(withMode, f)
+		}
+	}
+	// Modes are applied once all the files are created, to files first and
+	// then to directories, deepest first, so that making a directory
+	// read-only does not prevent populating it.
+	sort.SliceStable(withMode, func(i, j int) bool {
+		fi, fj := withMode[i], withMode[j]
+		if fi.isDir != fj.isDir {
+			return !fi.isDir
+		}
+		return strings.Count(fi.path, "/") > strings.Count(fj.path, "/")
+	})
+	for _, f := range withMode {
+		if f.isDir {
+			// Restore write permission before the directory is removed. This
+			// runs before the removal, as cleanup functions are called in
+			// reverse registration order.
+			p := filepath.Join(dir, filepath.FromSlash(f.path))
+			c.cleanup(func() {
+				os.Chmod(p, 0700)
+			})
+		}
+		c.Assert(f.chmod(dir), IsNil, Commentf("file %q", f.path))
+	}
+	return dir
+}
+
+// fixture describes a file provided to MkdirFrom or MkdirFiles, or included
+// in a txtar archive provided to DirEquals.
+type fixture struct {
+	// path holds the slash-separated path of the file, relative to the
+	// directory.
+	path    string
+	isDir   bool
+	mode    os.FileMode
+	hasMode bool
+	// target holds the target of symbolic links.
+	target string
+}
+
+// parseFixture parses the given file name, which can include attributes, and
+// checks that the given contents are valid for the described file.
+func parseFixture(name, contents string) (*fixture, error) {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return nil, errors.New("empty file name")
+	}
+	f := &fixture{
+		isDir: strings.HasSuffix(fields[0], "/"),
+		path:  path.Clean(fields[0]),
+	}
+	for i := 1; i < len(fields); i++ {
+		switch field := fields[i]; {
+		case field == "->" && i+1 < len(fields):
+			i++
+			f.target = fields[i]
+		case strings.HasPrefix(field, "mode="):
+			m, err := strconv.ParseUint(strings.TrimPrefix(field, "mode="), 8, 32)
+			if err != nil || os.FileMode(m) != os.FileMode(m).Perm() {
+				return nil, fmt.Errorf("invalid mode %q", field)
+			}
+			f.mode, f.hasMode = os.FileMode(m), true
+		default:
+			return nil, fmt.Errorf("invalid attribute %q", field)
+		}
+	}
+	if path.IsAbs(f.path) || f.path == "." || f.path == ".." || strings.HasPrefix(f.path, "../") {
+		return nil, errors.New("file name must be a path relative to the directory")
+	}
+	switch {
+	case f.target != "":
+		if f.isDir || f.hasMode || contents != "" {
+			return nil, errors.New("symbolic links cannot have a mode or contents")
+		}
+	case f.isDir:
+		if contents != "" {
+			return nil, errors.New("directories cannot have contents")
+		}
+	}
+	return f, nil
+}
+
+// write creates the file in dir with the given contents.
+func (f *fixture) write(dir, contents string) error {
+	p := filepath.Join(dir, filepath.FromSlash(f.path))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	switch {
+	case f.target != "":
+		return os.Symlink(filepath.FromSlash(f.target), p)
+	case f.isDir:
+		if err := os.MkdirAll(p, 0755); err != nil {
+			return err
+		}
+	default:
+		if err := ioutil.WriteFile(p, []byte(contents), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chmod sets the mode of the file in dir. The mode is set explicitly, as
+// WriteFile and MkdirAll are subject to the umask.
+func (f *fixture) chmod(dir string) error {
+	return os.Chmod(filepath.Join(dir, filepath.FromSlash(f.path)), f.mode)
+}
+
 // cleanup uses Cleanup when it can, falling back to using Defer.
 func (c *C) cleanup(f func()) {
 	if tb, ok := c.TB.(cleaner); ok {
@@ -4,10 +4,14 @@ package quicktest_test
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/rogpeppe/go-internal/txtar"
+
 	qt "github.com/frankban/quicktest"
 )
 
@@ -106,6 +110,148 @@ func TestCMkdir(t *testing.T) {
 	c.Assert(err, qt.Not(qt.IsNil))
 }
 
+func TestCMkdirFrom(t *testing.T) {
+	c := qt.New(t)
+	var dir string
+	testCleanup(t, func(c *qt.C) {
+		dir = c.MkdirFrom(`
+comment
+-- go.mod --
+module example.com/hello
+-- hello/hello.go --
+package hello
+-- cache/ --
+`)
+		c.Assert(dir, qt.DirEquals(), txtar.Parse([]byte(`
+-- go.mod --
+module example.com/hello
+-- hello/hello.go --
+package hello
+`)))
+		c.Assert(filepath.Join(dir, "cache"), qt.IsDir)
+	})
+	_, err := os.Stat(dir)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestCMkdirFromModesAndSymlinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes and symbolic links are not fully supported on windows")
+	}
+	c := qt.New(t)
+	dir := c.MkdirFrom(`
+-- bin/run.sh mode=0755 --
+#!/bin/sh
+-- current -> bin/run.sh --
+-- private/ mode=0700 --
+-- locked.txt mode=0000 --
+`)
+	c.Assert(filepath.Join(dir, "bin/run.sh"), qt.FileMode, 0755)
+	c.Assert(filepath.Join(dir, "current"), qt.LinksTo, "bin/run.sh")
+	c.Assert(filepath.Join(dir, "current"), qt.FileContents(qt.Equals), "#!/bin/sh\n")
+	c.Assert(filepath.Join(dir, "private"), qt.FileMode, 0700)
+	c.Assert(filepath.Join(dir, "locked.txt"), qt.FileMode, 0)
+}
+
+func TestCMkdirFromReadOnlyDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not fully supported on windows")
+	}
+	var dir string
+	t.Run("subtest", func(t *testing.T) {
+		c := qt.New(t)
+		if _, ok := c.TB.(cleaner); !ok {
+			// Calling Done is required when testing on Go < 1.14.
+			defer c.Done()
+		}
+		dir = c.MkdirFrom(`
+-- ro/ mode=0555 --
+-- ro/sub/ mode=0500 --
+-- ro/sub/file.txt mode=0400 --
+hello
+-- ro/file.txt --
+world
+`)
+		c.Assert(filepath.Join(dir, "ro"), qt.FileMode, 0555)
+		c.Assert(filepath.Join(dir, "ro/sub"), qt.FileMode, 0500)
+		c.Assert(filepath.Join(dir, "ro/sub/file.txt"), qt.FileContents(qt.Equals), "hello\n")
+		c.Assert(filepath.Join(dir, "ro/file.txt"), qt.FileContents(qt.Equals), "world\n")
+	})
+	_, err := os.Stat(dir)
+	qt.New(t).Assert(os.IsNotExist(err), qt.IsTrue, qt.Commentf("stat error: %v", err))
+}
+
+func TestCMkdirFiles(t *testing.T) {
+	c := qt.New(t)
+	dir := c.MkdirFiles(map[string]string{
+		"a.txt":          "a\n",
+		"sub/dir/b.txt":  "b\n",
+		"empty/":         "",
+		"sub/c.txt -> d": "",
+	})
+	c.Assert(filepath.Join(dir, "a.txt"), qt.FileContents(qt.Equals), "a\n")
+	c.Assert(filepath.Join(dir, "sub/dir/b.txt"), qt.FileContents(qt.Equals), "b\n")
+	c.Assert(filepath.Join(dir, "empty"), qt.IsDir)
+	if runtime.GOOS != "windows" {
+		c.Assert(filepath.Join(dir, "sub/c.txt"), qt.LinksTo, "d")
+	}
+}
+
+var mkdirFilesErrorTests = []struct {
+	about       string
+	name        string
+	contents    string
+	expectedErr string
+}{{
+	about:       "invalid mode",
+	name:        "a.txt mode=999",
+	expectedErr: `invalid mode "mode=999"`,
+}, {
+	about:       "non-permission mode bits",
+	name:        "a.txt mode=01755",
+	expectedErr: `invalid mode "mode=01755"`,
+}, {
+	about:       "invalid attribute",
+	name:        "a.txt executable",
+	expectedErr: `invalid attribute "executable"`,
+}, {
+	about:       "missing link target",
+	name:        "a.txt ->",
+	expectedErr: `invalid attribute "->"`,
+}, {
+	about:       "symbolic link with contents",
+	name:        "a.txt -> b.txt",
+	contents:    "contents",
+	expectedErr: `symbolic links cannot have a mode or contents`,
+}, {
+	about:       "directory with contents",
+	name:        "dir/",
+	contents:    "contents",
+	expectedErr: `directories cannot have contents`,
+}, {
+	about:       "path outside the directory",
+	name:        "../a.txt",
+	expectedErr: `file name must be a path relative to the directory`,
+}, {
+	about:       "absolute path",
+	name:        "/a.txt",
+	expectedErr: `file name must be a path relative to the directory`,
+}}
+
+func TestCMkdirFilesErrors(t *testing.T) {
+	for _, test := range mkdirFilesErrorTests {
+		t.Run(test.about, func(t *testing.T) {
+			tt := &testingT{TB: t}
+			qt.New(tt).MkdirFiles(map[string]string{
+				test.name: test.contents,
+			})
+			c := qt.New(t)
+			c.Assert(tt.fatalString(), qt.Contains, fmt.Sprintf("comment:\n  file %q\n", test.name))
+			c.Assert(tt.fatalString(), qt.Contains, test.expectedErr)
+		})
+	}
+}
+
 func testCleanup(t *testing.T, f func(c *qt.C)) {
 	t.Run("subtest", func(t *testing.T) {
 		c := qt.New(t)
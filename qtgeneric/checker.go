@@ -0,0 +1,208 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+//go:build go1.21
+// +build go1.21
+
+package qtgeneric
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// Equals returns a Checker checking equality of two comparable values.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, answer, qtgeneric.Equals(42))
+//	qtgeneric.Assert(t, n, qtgeneric.Equals[int64](42))
+//
+// See quicktest.Equals for more information.
+func Equals[T comparable](want T) Checker[T] {
+	return wrap[T](qt.Equals, want)
+}
+
+// DeepEquals returns a Checker deeply checking equality of two values of the
+// same type. See quicktest.DeepEquals for more information.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, got, qtgeneric.DeepEquals([]int{42, 47}))
+func DeepEquals[T any](want T) Checker[T] {
+	return wrap[T](qt.DeepEquals, want)
+}
+
+// CmpEquals returns a Checker checking equality of two values of the same type
+// according to the provided compare options. See quicktest.CmpEquals for more
+// information.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, list, qtgeneric.CmpEquals([]int{42, 47}, cmpopts.SortSlices(less)))
+func CmpEquals[T any](want T, opts ...cmp.Option) Checker[T] {
+	return wrap[T](qt.CmpEquals(opts...), want)
+}
+
+// ContentEquals returns a Checker like DeepEquals, except that any slices in
+// the compared values are sorted before being compared. See
+// quicktest.ContentEquals for more information.
+func ContentEquals[T any](want T) Checker[T] {
+	return wrap[T](qt.ContentEquals, want)
+}
+
+// Matches returns a Checker checking that a string matches the provided
+// regular expression pattern. The pattern is anchored.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, "these are the voyages", qtgeneric.Matches("these are .*"))
+func Matches(pattern string) Checker[string] {
+	return wrap[string](qt.Matches, pattern)
+}
+
+// ErrorMatches returns a Checker checking that an error is not nil and that
+// its message matches the provided regular expression pattern.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, err, qtgeneric.ErrorMatches("bad wolf .*"))
+func ErrorMatches(pattern string) Checker[error] {
+	return wrap[error](qt.ErrorMatches, pattern)
+}
+
+// ErrorIs returns a Checker checking that an error is or wraps the given
+// error value. This is analogous to calling errors.Is.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, err, qtgeneric.ErrorIs(os.ErrNotExist))
+func ErrorIs(want error) Checker[error] {
+	return wrap[error](qt.ErrorIs, want)
+}
+
+// ErrorAs returns a Checker checking that an error is or wraps an error of
+// type E. If so, the error is assigned to the value pointed to by target.
+// This is analogous to calling errors.As.
+//
+// For instance:
+//
+//	var pathError *os.PathError
+//	if qtgeneric.Check(t, err, qtgeneric.ErrorAs(&pathError)) {
+//	    qtgeneric.Assert(t, pathError.Path, qtgeneric.Equals("some_path"))
+//	}
+func ErrorAs[E any](target *E) Checker[error] {
+	return wrap[error](qt.ErrorAs, target)
+}
+
+// IsNil returns a Checker checking that a value is nil. See quicktest.IsNil
+// for more information.
+//
+// The type parameter usually needs to be specified explicitly, as it cannot be
+// inferred:
+//
+//	qtgeneric.Assert(t, err, qtgeneric.IsNil[error]())
+func IsNil[T any]() Checker[T] {
+	return wrap[T](qt.IsNil)
+}
+
+// IsNotNil returns a Checker checking that a value is not nil.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, got, qtgeneric.IsNotNil[*Thing]())
+func IsNotNil[T any]() Checker[T] {
+	return wrap[T](qt.IsNotNil)
+}
+
+// IsTrue is a Checker checking that a bool value is true.
+var IsTrue Checker[bool] = wrap[bool](qt.IsTrue)
+
+// IsFalse is a Checker checking that a bool value is false.
+var IsFalse Checker[bool] = wrap[bool](qt.IsFalse)
+
+// HasLen returns a Checker checking that a value has the given length. Since
+// there is no type constraint for types supporting len, the value is checked
+// at run time to be an array, channel, map, slice or string.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, []int{42, 47}, qtgeneric.HasLen[[]int](2))
+func HasLen[T any](n int) Checker[T] {
+	return wrap[T](qt.HasLen, n)
+}
+
+// Satisfies returns a Checker checking that a value, when used as argument of
+// the provided predicate function, causes the function to return true.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, f, qtgeneric.Satisfies(math.IsNaN))
+func Satisfies[T any](predicate func(T) bool) Checker[T] {
+	return wrap[T](qt.Satisfies, predicate)
+}
+
+// Not returns a Checker negating the given Checker.
+//
+// For instance:
+//
+//	qtgeneric.Assert(t, answer, qtgeneric.Not(qtgeneric.Equals(42)))
+func Not[T any](checker Checker[T]) Checker[T] {
+	return &notChecker[T]{
+		checker: checker,
+	}
+}
+
+type notChecker[T any] struct {
+	checker Checker[T]
+}
+
+// Check implements Checker.Check by checking that the stored checker fails.
+func (c *notChecker[T]) Check(got T, note func(key string, value interface{})) error {
+	if w, ok := c.checker.(*wrapper[T]); ok {
+		// Negate the quicktest checker itself, so that the failure output is
+		// the same, including the messages specific to checkers like IsNil.
+		return qt.Not(w.checker).Check(got, w.args, note)
+	}
+	return qt.Not(adapt(c.checker)).Check(got, c.checker.Args(), note)
+}
+
+// ArgNames implements Checker.ArgNames.
+func (c *notChecker[T]) ArgNames() []string {
+	return c.checker.ArgNames()
+}
+
+// Args implements Checker.Args.
+func (c *notChecker[T]) Args() []interface{} {
+	return c.checker.Args()
+}
+
+// wrap returns a Checker[T] running the given quicktest checker with the
+// given args.
+func wrap[T any](checker qt.Checker, args ...interface{}) Checker[T] {
+	return &wrapper[T]{
+		checker: checker,
+		args:    args,
+	}
+}
+
+// wrapper implements Checker[T] by running a quicktest checker.
+type wrapper[T any] struct {
+	checker qt.Checker
+	args    []interface{}
+}
+
+// Check implements Checker.Check by running the wrapped checker.
+func (c *wrapper[T]) Check(got T, note func(key string, value interface{})) error {
+	return c.checker.Check(got, c.args, note)
+}
+
+// ArgNames implements Checker.ArgNames.
+func (c *wrapper[T]) ArgNames() []string {
+	return c.checker.ArgNames()
+}
+
+// Args implements Checker.Args.
+func (c *wrapper[T]) Args() []interface{} {
+	return c.args
+}
@@ -0,0 +1,31 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+/*
+Package qtgeneric provides a type parameterised companion API for quicktest.
+
+The checkers in the quicktest package accept got values and arguments of type
+interface{}, so mismatches between the two, or a wrong number of arguments,
+are only detected when the check runs. The checkers in this package are bound
+to the type of the value being checked, so that those mistakes become compile
+errors:
+
+	import (
+	    qt "github.com/frankban/quicktest"
+	    "github.com/frankban/quicktest/qtgeneric"
+	)
+
+	func TestFoo(t *testing.T) {
+	    var n int64 = somepackage.Count()
+	    qtgeneric.Assert(t, n, qtgeneric.Equals[int64](42))
+	    qtgeneric.Check(t, somepackage.Names(), qtgeneric.DeepEquals([]string{"a", "b"}))
+	    qtgeneric.Assert(t, somepackage.Err(), qtgeneric.ErrorIs(io.EOF), qt.Commentf("a comment"))
+	}
+
+Failures are reported using the quicktest machinery, so the output is the same
+as the one produced by the corresponding quicktest checkers.
+
+This package requires Go >= 1.21: the quicktest module declares an older Go
+version, and only Go >= 1.21 allows build constraints to enable type
+parameters in its files.
+*/
+package qtgeneric
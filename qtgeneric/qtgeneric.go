@@ -0,0 +1,102 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+//go:build go1.21
+// +build go1.21
+
+package qtgeneric
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// Checker is implemented by types used to check values of type T as part of
+// Check/Assert invocations.
+type Checker[T any] interface {
+	// Check checks that the obtained value (got) is correct with respect to
+	// the checker's arguments. The returned error and the notes are handled
+	// as described in the quicktest.Checker documentation.
+	Check(got T, note func(key string, value interface{})) error
+
+	// ArgNames returns the names of all required arguments, including the
+	// mandatory got argument and any additional args returned by Args.
+	ArgNames() []string
+
+	// Args returns the checker arguments, excluding got. The values are
+	// included in the failure output when the check fails.
+	Args() []interface{}
+}
+
+// Check runs the given check using the provided t and continues execution in
+// case of failure. For instance:
+//
+//	qtgeneric.Check(t, answer, qtgeneric.Equals(42))
+//	qtgeneric.Check(t, got, qtgeneric.IsNil[*T](), qt.Commentf("iteration %d", i))
+//
+// If t is a *quicktest.C, its format function is used to print values.
+func Check[T any](t testing.TB, got T, checker Checker[T], comments ...qt.Comment) bool {
+	t.Helper()
+	c, args := prepare(t, checker, comments)
+	return c.Check(got, adapt(checker), args...)
+}
+
+// Assert runs the given check using the provided t and stops execution in case
+// of failure. For instance:
+//
+//	qtgeneric.Assert(t, got, qtgeneric.DeepEquals([]int{42, 47}))
+//	qtgeneric.Assert(t, err, qtgeneric.ErrorMatches("bad wolf .*"), qt.Commentf("a comment"))
+//
+// If t is a *quicktest.C, its format function is used to print values.
+func Assert[T any](t testing.TB, got T, checker Checker[T], comments ...qt.Comment) bool {
+	t.Helper()
+	c, args := prepare(t, checker, comments)
+	return c.Assert(got, adapt(checker), args...)
+}
+
+// prepare returns the quicktest checker instance to use for running a check
+// and the arguments to pass along with it.
+func prepare[T any](t testing.TB, checker Checker[T], comments []qt.Comment) (*qt.C, []interface{}) {
+	c, ok := t.(*qt.C)
+	if !ok {
+		c = qt.New(t)
+	}
+	var args []interface{}
+	if checker != nil {
+		args = append(args, checker.Args()...)
+	}
+	for _, comment := range comments {
+		args = append(args, comment)
+	}
+	return c, args
+}
+
+// adapt returns a quicktest.Checker running the given typed checker.
+func adapt[T any](checker Checker[T]) qt.Checker {
+	if checker == nil {
+		// Let quicktest report the nil checker.
+		return nil
+	}
+	return &adapter[T]{
+		checker: checker,
+	}
+}
+
+// adapter implements quicktest.Checker by running a Checker[T].
+type adapter[T any] struct {
+	checker Checker[T]
+}
+
+// Check implements quicktest.Checker.Check by running the typed checker.
+// The provided args are ignored, as they are already stored in the checker.
+func (a *adapter[T]) Check(got interface{}, args []interface{}, note func(key string, value interface{})) error {
+	// When T is an interface type, got can be nil: in that case v holds the
+	// zero value for T.
+	v, _ := got.(T)
+	return a.checker.Check(v, note)
+}
+
+// ArgNames implements quicktest.Checker.ArgNames.
+func (a *adapter[T]) ArgNames() []string {
+	return a.checker.ArgNames()
+}
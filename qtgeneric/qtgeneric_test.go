@@ -0,0 +1,234 @@
+// Licensed under the MIT license, see LICENSE file for details.
+
+//go:build go1.21
+// +build go1.21
+
+package qtgeneric_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/frankban/quicktest/qtgeneric"
+)
+
+func TestCheckSuccess(t *testing.T) {
+	tt := &testingT{}
+	var n int64 = 42
+	ok := qtgeneric.Check(tt, n, qtgeneric.Equals[int64](42))
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, []string{"a", "b"}, qtgeneric.DeepEquals([]string{"a", "b"}))
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, fmt.Errorf("wrapped: %w", io.EOF), qtgeneric.ErrorIs(io.EOF))
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, nil, qtgeneric.IsNil[error]())
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, math.NaN(), qtgeneric.Satisfies(math.IsNaN))
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, map[string]int{"a": 1}, qtgeneric.HasLen[map[string]int](1))
+	qt.Assert(t, ok, qt.IsTrue)
+	ok = qtgeneric.Check(tt, 47, qtgeneric.Not(qtgeneric.Equals(42)))
+	qt.Assert(t, ok, qt.IsTrue)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+	qt.Assert(t, tt.fatalString(), qt.Equals, "")
+}
+
+func TestErrorAs(t *testing.T) {
+	tt := &testingT{}
+	var pathErr *os.PathError
+	err := fmt.Errorf("wrapped: %w", &os.PathError{Path: "some_path"})
+	ok := qtgeneric.Check(tt, err, qtgeneric.ErrorAs(&pathErr))
+	qt.Assert(t, ok, qt.IsTrue)
+	qt.Assert(t, pathErr.Path, qt.Equals, "some_path")
+}
+
+var failureTests = []struct {
+	about   string
+	check   func(t testing.TB) bool
+	checker qt.Checker
+	got     interface{}
+	args    []interface{}
+}{{
+	about: "Equals",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, "42", qtgeneric.Equals("47"))
+	},
+	checker: qt.Equals,
+	got:     "42",
+	args:    []interface{}{"47"},
+}, {
+	about: "Equals with comment",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, 42, qtgeneric.Equals(47), qt.Commentf("answer is %d", 42))
+	},
+	checker: qt.Equals,
+	got:     42,
+	args:    []interface{}{47, qt.Commentf("answer is %d", 42)},
+}, {
+	about: "DeepEquals",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, []int{42}, qtgeneric.DeepEquals([]int{42, 47}))
+	},
+	checker: qt.DeepEquals,
+	got:     []int{42},
+	args:    []interface{}{[]int{42, 47}},
+}, {
+	about: "ErrorIs",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, errors.New("bad wolf"), qtgeneric.ErrorIs(io.EOF))
+	},
+	checker: qt.ErrorIs,
+	got:     errors.New("bad wolf"),
+	args:    []interface{}{io.EOF},
+}, {
+	about: "ErrorMatches",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, errors.New("bad wolf"), qtgeneric.ErrorMatches("good .*"))
+	},
+	checker: qt.ErrorMatches,
+	got:     errors.New("bad wolf"),
+	args:    []interface{}{"good .*"},
+}, {
+	about: "IsNil",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, errors.New("bad wolf"), qtgeneric.IsNil[error]())
+	},
+	checker: qt.IsNil,
+	got:     errors.New("bad wolf"),
+}, {
+	about: "HasLen",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, "hello", qtgeneric.HasLen[string](2))
+	},
+	checker: qt.HasLen,
+	got:     "hello",
+	args:    []interface{}{2},
+}, {
+	about: "IsTrue",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, false, qtgeneric.IsTrue)
+	},
+	checker: qt.IsTrue,
+	got:     false,
+}, {
+	about: "Not",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, 42, qtgeneric.Not(qtgeneric.Equals(42)))
+	},
+	checker: qt.Not(qt.Equals),
+	got:     42,
+	args:    []interface{}{42},
+}, {
+	about: "Not IsNil",
+	check: func(t testing.TB) bool {
+		return qtgeneric.Check(t, (*int)(nil), qtgeneric.Not(qtgeneric.IsNil[*int]()))
+	},
+	checker: qt.Not(qt.IsNil),
+	got:     (*int)(nil),
+}}
+
+func TestFailureOutput(t *testing.T) {
+	for _, test := range failureTests {
+		t.Run(test.about, func(t *testing.T) {
+			tt := &testingT{}
+			ok := test.check(tt)
+			qt.Assert(t, ok, qt.IsFalse)
+
+			want := &testingT{}
+			qt.Check(want, test.got, test.checker, test.args...)
+			qt.Assert(t, want.errorString(), qt.Not(qt.Equals), "")
+			// The failure output is the same as the one produced by the
+			// corresponding quicktest checker, apart from the stack.
+			qt.Assert(t, stripStack(tt.errorString()), qt.Equals, stripStack(want.errorString()))
+		})
+	}
+}
+
+func TestAssertStack(t *testing.T) {
+	tt := &testingT{}
+	ok := qtgeneric.Assert(tt, 42, qtgeneric.Equals(47))
+	qt.Assert(t, ok, qt.IsFalse)
+	qt.Assert(t, tt.errorString(), qt.Equals, "")
+	qt.Assert(t, tt.fatalString(), qt.Matches, `(?s).*
+stack:
+  .*qtgeneric_test.go:\d+
+    ok := qtgeneric.Assert\(tt, 42, qtgeneric.Equals\(47\)\)
+`)
+}
+
+func TestCheckWithC(t *testing.T) {
+	tt := &testingT{}
+	c := qt.New(tt)
+	c.SetFormat(func(v interface{}) string {
+		return fmt.Sprintf("formatted %v", v)
+	})
+	ok := qtgeneric.Check(c, 42, qtgeneric.Equals(47))
+	qt.Assert(t, ok, qt.IsFalse)
+	qt.Assert(t, tt.errorString(), qt.Matches, `(?s)
+error:
+  values are not equal
+got:
+  formatted 42
+want:
+  formatted 47
+stack:
+.*`)
+}
+
+func TestCheckNilChecker(t *testing.T) {
+	tt := &testingT{}
+	ok := qtgeneric.Check[int](tt, 42, nil)
+	qt.Assert(t, ok, qt.IsFalse)
+	qt.Assert(t, tt.errorString(), qt.Matches, `(?s)
+error:
+  bad check: nil checker provided
+stack:
+.*`)
+}
+
+// stripStack removes the stack from the given failure output.
+func stripStack(s string) string {
+	if i := strings.Index(s, "stack:\n"); i != -1 {
+		return s[:i]
+	}
+	return s
+}
+
+// testingT can be passed to qtgeneric functions for testing purposes.
+type testingT struct {
+	testing.TB
+
+	errorBuf bytes.Buffer
+	fatalBuf bytes.Buffer
+}
+
+// Error overrides testing.TB.Error so that messages are collected.
+func (t *testingT) Error(a ...interface{}) {
+	fmt.Fprint(&t.errorBuf, a...)
+}
+
+// Fatal overrides testing.TB.Fatal so that messages are collected and the
+// goroutine is not killed.
+func (t *testingT) Fatal(a ...interface{}) {
+	fmt.Fprint(&t.fatalBuf, a...)
+}
+
+// Helper overrides testing.TB.Helper.
+func (t *testingT) Helper() {}
+
+// errorString returns the error message.
+func (t *testingT) errorString() string {
+	return t.errorBuf.String()
+}
+
+// fatalString returns the fatal error message.
+func (t *testingT) fatalString() string {
+	return t.fatalBuf.String()
+}
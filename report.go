@@ -153,12 +153,18 @@ func writeStack(w io.Writer) {
 	runtime.Callers(5, pc)
 	frames := runtime.CallersFrames(pc)
 	thisPackage := reflect.TypeOf(C{}).PkgPath() + "."
+	genericPackage := reflect.TypeOf(C{}).PkgPath() + "/qtgeneric."
 	for {
 		frame, more := frames.Next()
 		if strings.HasPrefix(frame.Function, "testing.") {
 			// Stop before getting back to stdlib test runner calls.
 			break
 		}
+		if strings.HasPrefix(frame.Function, genericPackage) {
+			// Continue without printing frames for the qtgeneric API, which
+			// wraps the quicktest one.
+			continue
+		}
 		if fname := strings.TrimPrefix(frame.Function, thisPackage); fname != frame.Function {
 			if ast.IsExported(fname) {
 				// Continue without printing frames for quicktest exported API.